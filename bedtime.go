@@ -27,6 +27,9 @@ type IdealBedtimes struct {
 //
 //	"If you omit the start date, it will be set to one week ago.
 //	 If you omit the end date, it will be set to the current day."
+//
+// SleepTimes is the v2 equivalent. Use IdealBedtimes.SleepTimes to convert the results into the
+// same shape.
 func (c *Client) GetBedtime(ctx context.Context, start, end string) (*IdealBedtimes, *http.Response, error) {
 	path := "v1/bedtime"
 	params := url.Values{}
@@ -0,0 +1,244 @@
+package oura
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// CheckpointKey identifies a paginated fetch whose progress is recorded in a CheckpointStore.
+type CheckpointKey struct {
+	// The collection being fetched, eg `heartrate`
+	Collection string
+
+	// The user whose data is being fetched
+	UserID string
+}
+
+// Checkpoint records the progress of a paginated fetch.
+type Checkpoint struct {
+	// The pagination token of the next page to fetch
+	NextToken string `json:"next_token,omitempty"`
+
+	// The end of the last date window that was fetched completely
+	CompletedWindow string `json:"completed_window,omitempty"`
+
+	// A fingerprint of the range or windows being fetched. A checkpoint is only resumed by a
+	// fetch of the same range or windows.
+	Query string `json:"query,omitempty"`
+
+	// When the checkpoint was saved
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CheckpointStore persists the progress of paginated fetches so an interrupted fetch can be resumed.
+type CheckpointStore interface {
+	// Load returns the checkpoint saved for the key, or nil if there isn't one.
+	Load(ctx context.Context, key CheckpointKey) (*Checkpoint, error)
+
+	// Save saves the checkpoint for the key, replacing any existing checkpoint.
+	Save(ctx context.Context, key CheckpointKey, cp Checkpoint) error
+
+	// Delete removes the checkpoint for the key. It is not an error if there isn't one.
+	Delete(ctx context.Context, key CheckpointKey) error
+}
+
+// FileCheckpointStore is a CheckpointStore saving each checkpoint as a JSON file in a directory.
+type FileCheckpointStore struct {
+	dir string
+}
+
+// NewFileCheckpointStore returns a FileCheckpointStore saving checkpoints in dir, creating the
+// directory if it doesn't exist.
+func NewFileCheckpointStore(dir string) (*FileCheckpointStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileCheckpointStore{dir: dir}, nil
+}
+
+// path returns the file of the checkpoint for the key. The file is named by a hash of the key, as
+// user IDs and collection names can contain any character, including the separator between them.
+func (s *FileCheckpointStore) path(key CheckpointKey) string {
+	sum := sha256.Sum256([]byte(url.PathEscape(key.UserID) + "/" + url.PathEscape(key.Collection)))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+// Load returns the checkpoint saved for the key, or nil if there isn't one.
+func (s *FileCheckpointStore) Load(_ context.Context, key CheckpointKey) (*Checkpoint, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %w", s.path(key), err)
+	}
+	return &cp, nil
+}
+
+// Save saves the checkpoint for the key, replacing any existing checkpoint.
+func (s *FileCheckpointStore) Save(_ context.Context, key CheckpointKey, cp Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(s.path(key), data, 0o600)
+}
+
+// Delete removes the checkpoint for the key.
+func (s *FileCheckpointStore) Delete(_ context.Context, key CheckpointKey) error {
+	err := os.Remove(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Resume is like ListAll for a paginated v2 collection method, such as Client.Heartrates, but
+// records the pagination token of the next page in store after each page is fetched. If a previous
+// call with the same key, start and end didn't finish, fetching resumes from the page where it
+// stopped. A checkpoint saved for a different start or end is ignored and replaced, as pagination
+// tokens are only valid for the query that returned them. The checkpoint is deleted once all of
+// the pages have been fetched.
+//
+// Only the data fetched by this call is returned.
+func Resume[T any, P collection[T]](ctx context.Context, store CheckpointStore, key CheckpointKey, list func(ctx context.Context, start, end, next string) (P, *http.Response, error), start, end string, maxPages int) ([]T, error) {
+	cp, err := store.Load(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("loading checkpoint: %w", err)
+	}
+
+	fetch := Pages(list, start, end)
+	query := fingerprint([]Window{{Start: start, End: end}})
+	resumeFrom := ""
+	if cp != nil && cp.Query == query {
+		resumeFrom = cp.NextToken
+	}
+
+	first := true
+	checkpointed := func(ctx context.Context, next string) ([]T, *string, error) {
+		if first {
+			next, first = resumeFrom, false
+		}
+
+		data, token, err := fetch(ctx, next)
+		if err != nil || token == nil || *token == "" {
+			return data, token, err
+		}
+		if err := store.Save(ctx, key, Checkpoint{NextToken: *token, Query: query, UpdatedAt: time.Now()}); err != nil {
+			return data, nil, fmt.Errorf("saving checkpoint: %w", err)
+		}
+		return data, token, nil
+	}
+
+	all, err := ListAll(ctx, maxPages, checkpointed)
+	if err != nil {
+		return all, err
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		return all, fmt.Errorf("deleting checkpoint: %w", err)
+	}
+	return all, nil
+}
+
+// Window is a date or datetime range, formatted as expected by the collection being fetched.
+type Window struct {
+	Start string
+	End   string
+}
+
+// ResumeWindows fetches each window in turn, recording the end of each window in store once it has
+// been fetched completely. If a previous call with the same key and windows didn't finish, the
+// windows up to and including the last completed one are skipped. A checkpoint saved for
+// different windows is ignored and replaced. The checkpoint is deleted once all of the windows
+// have been fetched.
+//
+// Only the data fetched by this call is returned, along with any error.
+func ResumeWindows[T any](ctx context.Context, store CheckpointStore, key CheckpointKey, windows []Window, fetch func(ctx context.Context, w Window) ([]T, error)) ([]T, error) {
+	cp, err := store.Load(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("loading checkpoint: %w", err)
+	}
+
+	query := fingerprint(windows)
+	if cp != nil && cp.Query == query && cp.CompletedWindow != "" {
+		i := slices.IndexFunc(windows, func(w Window) bool { return w.End == cp.CompletedWindow })
+		if i < 0 {
+			return nil, fmt.Errorf("invalid checkpoint: completed window %s is not one of the windows", cp.CompletedWindow)
+		}
+		windows = windows[i+1:]
+	}
+
+	var all []T
+	for _, w := range windows {
+		if err := ctx.Err(); err != nil {
+			return all, err
+		}
+
+		data, err := fetch(ctx, w)
+		all = append(all, data...)
+		if err != nil {
+			return all, err
+		}
+		if err := store.Save(ctx, key, Checkpoint{CompletedWindow: w.End, Query: query, UpdatedAt: time.Now()}); err != nil {
+			return all, fmt.Errorf("saving checkpoint: %w", err)
+		}
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		return all, fmt.Errorf("deleting checkpoint: %w", err)
+	}
+	return all, nil
+}
+
+// fingerprint returns a short hash identifying a list of windows.
+func fingerprint(windows []Window) string {
+	h := sha256.New()
+	for _, w := range windows {
+		fmt.Fprintf(h, "%s\x00%s\x00", w.Start, w.End)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory as name and renames it
+// to name, so that readers never see a partially written file.
+func writeFileAtomic(name string, data []byte, perm fs.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp) // a no-op once the file has been renamed
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(perm); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, name)
+}
@@ -0,0 +1,168 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "checkpoints")
+	store, err := NewFileCheckpointStore(dir)
+	assert.NoError(t, err, "should create the directory")
+
+	key := CheckpointKey{Collection: "heartrate", UserID: "user/1"}
+
+	cp, err := store.Load(ctx, key)
+	assert.NoError(t, err, "should not return an error for a missing checkpoint")
+	assert.Nil(t, cp)
+
+	saved := Checkpoint{NextToken: "abc", UpdatedAt: time.Date(2022, 3, 20, 12, 0, 0, 0, time.UTC)}
+	assert.NoError(t, store.Save(ctx, key, saved))
+
+	cp, err = store.Load(ctx, key)
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, &saved, cp)
+
+	other, err := store.Load(ctx, CheckpointKey{Collection: "sleep", UserID: "user/1"})
+	assert.NoError(t, err, "should not return an error")
+	assert.Nil(t, other, "should keep checkpoints for other collections separate")
+
+	assert.NoError(t, store.Save(ctx, CheckpointKey{Collection: "sleep", UserID: "alice_daily"}, Checkpoint{NextToken: "alice_daily"}))
+	assert.NoError(t, store.Save(ctx, CheckpointKey{Collection: "daily_sleep", UserID: "alice"}, Checkpoint{NextToken: "alice"}))
+	cp, _ = store.Load(ctx, CheckpointKey{Collection: "sleep", UserID: "alice_daily"})
+	assert.Equal(t, "alice_daily", cp.NextToken, "should keep checkpoints for keys joined the same way separate")
+	assert.NoError(t, store.Delete(ctx, CheckpointKey{Collection: "sleep", UserID: "alice_daily"}))
+	assert.NoError(t, store.Delete(ctx, CheckpointKey{Collection: "daily_sleep", UserID: "alice"}))
+
+	files, _ := os.ReadDir(dir)
+	assert.Len(t, files, 1, "should not leave temporary files behind")
+	info, _ := files[0].Info()
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	assert.NoError(t, store.Delete(ctx, key))
+	assert.NoError(t, store.Delete(ctx, key), "should not return an error deleting a missing checkpoint")
+	cp, _ = store.Load(ctx, key)
+	assert.Nil(t, cp)
+}
+
+func TestResume(t *testing.T) {
+	ctx := context.Background()
+	store, _ := NewFileCheckpointStore(t.TempDir())
+	key := CheckpointKey{Collection: "heartrate", UserID: "1"}
+	start, end := "2022-03-01T00:00:00Z", "2022-03-02T00:00:00Z"
+
+	client, mux, teardown := setup()
+	defer teardown()
+
+	handler := pagedHandler(t, 4, func(page int) string {
+		return fmt.Sprintf(`{"bpm": %d, "source": "awake", "timestamp": "2022-03-01T00:00:0%dZ"}`, 60+page, page)
+	})
+	var tokens []string
+	failOn := "page3"
+	mux.HandleFunc("/v2/usercollection/heartrate", func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("next_token")
+		tokens = append(tokens, token)
+		if failOn != "" && token == failOn {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		handler(w, r)
+	})
+
+	got, err := Resume(ctx, store, key, client.Heartrates, start, end, 0)
+	assert.Error(t, err, "should return the error of the failed page")
+	assert.Len(t, got, 2, "should return the pages fetched before the failure")
+
+	cp, _ := store.Load(ctx, key)
+	assert.Equal(t, "page3", cp.NextToken, "should record the token of the failed page")
+
+	failOn = ""
+	got, err = Resume(ctx, store, key, client.Heartrates, start, end, 0)
+	assert.NoError(t, err, "should not return an error")
+	assert.Len(t, got, 2, "should only return the remaining pages")
+	assert.Equal(t, 63, got[0].Bpm)
+	assert.Equal(t, []string{"", "page2", "page3", "page3", "page4"}, tokens, "should resume from the failed page")
+
+	cp, _ = store.Load(ctx, key)
+	assert.Nil(t, cp, "should delete the checkpoint once complete")
+
+	t.Run("ignores the checkpoint of a different range", func(t *testing.T) {
+		tokens = nil
+		failOn = "page3"
+		_, err := Resume(ctx, store, key, client.Heartrates, start, end, 0)
+		assert.Error(t, err)
+
+		failOn = ""
+		got, err := Resume(ctx, store, key, client.Heartrates, "2022-04-01T00:00:00Z", "2022-04-02T00:00:00Z", 0)
+		assert.NoError(t, err, "should not return an error")
+		assert.Len(t, got, 4, "should fetch all of the pages")
+		assert.Equal(t, []string{"", "page2", "page3", "", "page2", "page3", "page4"}, tokens, "should start from the first page")
+	})
+}
+
+func TestResumeWindows(t *testing.T) {
+	ctx := context.Background()
+	store, _ := NewFileCheckpointStore(t.TempDir())
+	key := CheckpointKey{Collection: "daily_sleep", UserID: "1"}
+	windows := []Window{
+		{Start: "2022-01-01", End: "2022-01-31"},
+		{Start: "2022-02-01", End: "2022-02-28"},
+		{Start: "2022-03-01", End: "2022-03-31"},
+	}
+
+	var fetched []string
+	fail := true
+	fetch := func(ctx context.Context, w Window) ([]string, error) {
+		fetched = append(fetched, w.Start)
+		if w.Start == "2022-02-01" && fail {
+			return []string{"partial"}, errors.New("window failed")
+		}
+		return []string{w.Start}, nil
+	}
+
+	got, err := ResumeWindows(ctx, store, key, windows, fetch)
+	assert.EqualError(t, err, "window failed")
+	assert.Equal(t, []string{"2022-01-01", "partial"}, got)
+
+	cp, _ := store.Load(ctx, key)
+	assert.Equal(t, "2022-01-31", cp.CompletedWindow)
+
+	fail = false
+	got, err = ResumeWindows(ctx, store, key, windows, fetch)
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, []string{"2022-02-01", "2022-03-01"}, got, "should skip the completed window")
+	assert.Equal(t, []string{"2022-01-01", "2022-02-01", "2022-02-01", "2022-03-01"}, fetched)
+
+	cp, _ = store.Load(ctx, key)
+	assert.Nil(t, cp, "should delete the checkpoint once complete")
+
+	t.Run("ignores the checkpoint of different windows", func(t *testing.T) {
+		fetched = nil
+		fail = true
+		_, err := ResumeWindows(ctx, store, key, windows, fetch)
+		assert.Error(t, err)
+
+		fail = false
+		other := []Window{{Start: "2023-01-01", End: "2023-01-31"}, windows[1]}
+		got, err := ResumeWindows(ctx, store, key, other, fetch)
+		assert.NoError(t, err, "should not return an error")
+		assert.Equal(t, []string{"2023-01-01", "2022-02-01"}, got, "should fetch all of the windows")
+	})
+
+	t.Run("returns an error if the completed window is unknown", func(t *testing.T) {
+		fetched = nil
+		assert.NoError(t, store.Save(ctx, key, Checkpoint{CompletedWindow: "2022-02-15", Query: fingerprint(windows)}))
+		_, err := ResumeWindows(ctx, store, key, windows, fetch)
+		assert.EqualError(t, err, "invalid checkpoint: completed window 2022-02-15 is not one of the windows")
+		assert.Empty(t, fetched, "should not fetch any windows")
+	})
+}
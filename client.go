@@ -24,30 +24,56 @@ type Client struct {
 
 	UserAgent string
 	client    *http.Client
+
+	// RetryPolicy controls how failed requests are retried. Requests aren't retried if it's nil.
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter limits the rate of requests made by the client. Requests aren't limited if it's nil.
+	RateLimiter *RateLimiter
+
+	logger   Logger
+	sandbox  bool
+	maxPages int
 }
 
 // NewClient returns a new Oura API client. If a nil httpClient is
 // provided, http.DefaultClient will be used. To use API methods which require
 // authentication, provide an http.Client that will perform the authentication
 // for you (such as that provided by the golang.org/x/oauth2 library).
-func NewClient(cc *http.Client) *Client {
+//
+// The client is configured with the given options, in order. An error is returned
+// if any of the options are invalid.
+func NewClient(cc *http.Client, opts ...Option) (*Client, error) {
 	if cc == nil {
 		cc = http.DefaultClient
 	}
-	baseURL, _ := url.Parse(BaseURL)
+	baseURL, err := url.Parse(BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("oura: invalid BaseURL: %w", err)
+	}
 
 	c := &Client{baseURL: baseURL, UserAgent: userAgent, client: cc}
-	return c
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, fmt.Errorf("oura: %w", err)
+		}
+	}
+	return c, nil
 }
 
 // NewRequest creates an HTTP Request. The client baseURL is checked to confirm that it has a trailing
 // slash. A relative URL should be provided without the leading slash. If a non-nil body is provided
-// it will be JSON encoded and included in the request.
+// it will be JSON encoded and included in the request. If the client is in sandbox mode, v2 user
+// collection URLs are rewritten to the equivalent sandbox collection.
 func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
 	if !strings.HasSuffix(c.baseURL.Path, "/") {
 		return nil, fmt.Errorf("client baseURL does not have a trailing slash: %q", c.baseURL)
 	}
 
+	if c.sandbox {
+		urlStr = sandboxPath(urlStr)
+	}
+
 	u, err := c.baseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -80,14 +106,44 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 }
 
 // Do sends a request and returns the response. An error is returned if the request cannot
-// be sent or if the API returns an error. If a response is received, the body response body
-// is decoded and stored in the value pointed to by v.
+// be sent or if the API returns an error, in which case it is an *APIError, or a *ScopeError
+// wrapping one if the token may be missing the scope of a collection. If a response is
+// received, the body response body is decoded and stored in the value pointed to by v.
+// Failed requests are retried according to the client's RetryPolicy, and each attempt waits
+// for the client's RateLimiter.
 func (c *Client) do(req *http.Request, v interface{}) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(req, v)
+		if !c.RetryPolicy.shouldRetry(req, attempt, resp, err) {
+			return resp, err
+		}
+
+		// Give up with the error we already have if the context is done or its deadline
+		// would pass before the next attempt.
+		wait := c.RetryPolicy.backoff(attempt, resp)
+		c.logf("retrying %s %s in %s (attempt %d of %d): %v", req.Method, req.URL, wait, attempt+1, c.RetryPolicy.MaxAttempts, err)
+		if sleep(req.Context(), wait) != nil {
+			return resp, err
+		}
+
+		if req, err = rewind(req); err != nil {
+			return resp, err
+		}
+	}
+}
+
+// send makes a single attempt at sending a request and decoding the response into v.
+func (c *Client) send(req *http.Request, v interface{}) (*http.Response, error) {
+	if err := c.RateLimiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
+	c.RateLimiter.update(resp.Header)
 
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -96,14 +152,7 @@ func (c *Client) do(req *http.Request, v interface{}) (*http.Response, error) {
 
 	// Anything other than a HTTP 2xx response code is treated as an error.
 	if resp.StatusCode >= http.StatusMultipleChoices {
-		e := errorDetail{}
-		err = json.Unmarshal(data, &e)
-		if err != nil {
-			return resp, err
-		}
-
-		err = errors.New(http.StatusText(resp.StatusCode) + ": " + e.Detail)
-		return resp, err
+		return resp, scopeError(req, newAPIError(resp, data))
 	}
 
 	if v != nil && len(data) != 0 {
@@ -117,6 +166,12 @@ func (c *Client) do(req *http.Request, v interface{}) (*http.Response, error) {
 	return resp, err
 }
 
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf("oura: "+format, v...)
+	}
+}
+
 // timeSeriesData is time series data used by various other methods.
 type timeSeriesData struct {
 	// The number of seconds between records
@@ -140,6 +195,30 @@ func (e *errorDetail) Error() string {
 	return e.Detail
 }
 
+// The paths of the v2 user collections and their sandbox equivalents.
+const (
+	userCollectionPath        = "v2/usercollection/"
+	sandboxUserCollectionPath = "v2/sandbox/usercollection/"
+)
+
+// sandboxPath rewrites a v2 user collection path, with or without a leading slash, to the
+// equivalent sandbox collection path. Any other path is returned unchanged.
+func sandboxPath(path string) string {
+	trimmed := strings.TrimPrefix(path, "/")
+	if !strings.HasPrefix(trimmed, userCollectionPath) {
+		return path
+	}
+	return path[:len(path)-len(trimmed)] + sandboxUserCollectionPath + strings.TrimPrefix(trimmed, userCollectionPath)
+}
+
+// documentPath returns the path of a single document in a v2 user collection.
+func documentPath(collection, id string) (string, error) {
+	if id == "" {
+		return "", errors.New("oura: document ID is required")
+	}
+	return userCollectionPath + collection + "/" + url.PathEscape(id), nil
+}
+
 // parametiseDate takes the arguments and URL encodes them into a string
 // where the dates are ISO 8601 date strings without times.
 func parametiseDate(path, start, end, next string) string {
@@ -6,7 +6,6 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
-	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,8 +14,9 @@ import (
 // TestNewClient confirms that a client can be created with the default baseURL
 // and default User-Agent.
 func TestNewClient(t *testing.T) {
-	c := NewClient(nil)
+	c, err := NewClient(nil)
 
+	assert.NoError(t, err, "should not return an error")
 	assert.Equal(t, BaseURL, c.baseURL.String(), "should configure the client to use the default url")
 	assert.Equal(t, "go-oura", c.UserAgent, "should configure the client to use the default user-agent")
 }
@@ -25,7 +25,7 @@ func TestNewClient(t *testing.T) {
 // correct URL, a correctly encoded body and the correct User-Agent and
 // Content-Type headers set.
 func TestNewRequest(t *testing.T) {
-	c := NewClient(nil)
+	c, _ := NewClient(nil)
 
 	t.Run("valid request", func(tc *testing.T) {
 		inURL, outURL := "foo", BaseURL+"foo"
@@ -49,7 +49,7 @@ func TestNewRequest(t *testing.T) {
 	})
 
 	t.Run("request with invalid JSON", func(tc *testing.T) {
-		type T struct{ A map[interface{}]interface{} }
+		type T struct{ A chan int }
 		_, err := c.NewRequest(context.Background(), "GET", ".", &T{})
 		assert.Error(tc, err, "should return an error")
 	})
@@ -221,6 +221,163 @@ func TestDo(t *testing.T) {
 	})
 }
 
+// TestGetDocument confirms that every single-document method requests the document's path and
+// returns a not found error for a missing document.
+func TestGetDocument(t *testing.T) {
+	cases := []struct {
+		collection string
+		get        func(ctx context.Context, c *Client, id string) (string, error)
+	}{
+		{"daily_activity", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetDailyActivity(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"daily_sleep", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetDailySleep(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"daily_readiness", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetDailyReadiness(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"sleep", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetSleepPeriod(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"session", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetSession(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"tag", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetTag(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"workout", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetWorkout(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"daily_spo2", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetDailySpO2(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"daily_stress", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetDailyStress(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"daily_resilience", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetDailyResilience(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"enhanced_tag", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetEnhancedTag(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"rest_mode_period", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetRestModePeriod(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"ring_configuration", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetRingConfiguration(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"sleep_time", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetSleepTime(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"vO2_max", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetVO2Max(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+		{"daily_cardiovascular_age", func(ctx context.Context, c *Client, id string) (string, error) {
+			d, _, err := c.GetDailyCardiovascularAge(ctx, id)
+			if d == nil {
+				return "", err
+			}
+			return d.ID, err
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.collection, func(t *testing.T) {
+			client, mux, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/v2/usercollection/"+tc.collection+"/", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				if r.URL.Path != "/v2/usercollection/"+tc.collection+"/abc-123" {
+					w.WriteHeader(http.StatusNotFound)
+					fmt.Fprint(w, `{"detail": "Document not found."}`)
+					return
+				}
+				fmt.Fprint(w, `{"id": "abc-123"}`)
+			})
+
+			ctx := context.Background()
+			id, err := tc.get(ctx, client, "abc-123")
+			assert.NoError(t, err, "should not return an error")
+			assert.Equal(t, "abc-123", id)
+
+			_, err = tc.get(ctx, client, "missing")
+			assert.True(t, IsNotFound(err), "should return a not found error")
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			_, err = tc.get(ctx, client, "")
+			assert.EqualError(t, err, "oura: document ID is required")
+		})
+	}
+}
+
+func TestDocumentPath(t *testing.T) {
+	path, err := documentPath("workout", "a/b c")
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, "v2/usercollection/workout/a%2Fb%20c", path, "should escape the ID")
+}
+
 // Setup establishes a test Server that can be used to provide mock responses during testing.
 // It returns a pointer to a client, a mux, the server URL and a teardown function that
 // must be called when testing is complete.
@@ -228,9 +385,7 @@ func setup() (client *Client, mux *http.ServeMux, teardown func()) {
 	mux = http.NewServeMux()
 	server := httptest.NewServer(mux)
 
-	c := NewClient(nil)
-	surl, _ := url.Parse(server.URL + "/")
-	c.baseURL = surl
+	c, _ := NewClient(nil, WithBaseURL(server.URL+"/"))
 
 	return c, mux, server.Close
 }
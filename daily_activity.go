@@ -2,6 +2,7 @@ package oura
 
 import (
 	"context"
+	"iter"
 	"net/http"
 )
 
@@ -37,6 +38,9 @@ type DailyActivity struct {
 	// High activity metabolic equivalent (MET) in seconds
 	HighActivityTime int `json:"high_activity_time"`
 
+	// Unique identifier of the document
+	ID string `json:"id"`
+
 	// Number of inactivity alerts received
 	InactivityAlerts int `json:"inactivity_alerts"`
 
@@ -96,6 +100,13 @@ type DailyActivities struct {
 	NextToken *string `json:"next_token,omitempty"`
 }
 
+func (d *DailyActivities) page() ([]DailyActivity, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
 // Contributors is an alias for ActivityContributors provided for compatibility with earlier users of this library.
 type Contributors = ActivityContributors
 
@@ -140,3 +151,49 @@ func (c *Client) DailyActivities(ctx context.Context, startDate, endDate, nextTo
 
 	return data, resp, nil
 }
+
+// DailyActivitiesAll gets the daily activity summaries within a given timeframe, following the
+// pagination tokens until all of the pages have been fetched. If a page fails to be fetched, the
+// data fetched so far is returned along with the error.
+func (c *Client) DailyActivitiesAll(ctx context.Context, startDate, endDate string) ([]DailyActivity, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.DailyActivities, startDate, endDate))
+}
+
+// DailyActivitiesSeq returns an iterator over the daily activity summaries within a given
+// timeframe. Each page is fetched when the iteration reaches it, and fetching stops when the
+// iteration does. If a page fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) DailyActivitiesSeq(ctx context.Context, startDate, endDate string) iter.Seq2[DailyActivity, error] {
+	return Seq(ctx, c.maxPages, Pages(c.DailyActivities, startDate, endDate))
+}
+
+// DailyActivitiesWithOptions gets the daily activity summaries using typed, validated parameters.
+// An error wrapping ErrInvalidRange is returned, without making a request, if the options are
+// invalid.
+func (c *Client) DailyActivitiesWithOptions(ctx context.Context, opts *ListOptions) (*DailyActivities, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.DailyActivities(ctx, start, end, next)
+}
+
+// GetDailyActivity gets a single daily activity summary by its ID. An error matching ErrNotFound is
+// returned if there is no daily activity summary with the ID.
+func (c *Client) GetDailyActivity(ctx context.Context, id string) (*DailyActivity, *http.Response, error) {
+	path, err := documentPath("daily_activity", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailyActivity
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
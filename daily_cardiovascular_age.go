@@ -0,0 +1,100 @@
+package oura
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+// DailyCardiovascularAge represents the cardiovascular age estimate for a single day.
+type DailyCardiovascularAge struct {
+	// Unique identifier of the document
+	ID string `json:"id"`
+
+	// The `YYYY-MM-DD` formatted local date indicating when the cardiovascular age was estimated
+	Day string `json:"day"`
+
+	// The estimated age (in years) of the user's vascular system
+	VascularAge *int `json:"vascular_age,omitempty"`
+}
+
+// DailyCardiovascularAges represents the daily cardiovascular age estimates within a given timeframe.
+type DailyCardiovascularAges struct {
+	Data []DailyCardiovascularAge `json:"data"`
+	// Pagination token
+	NextToken *string `json:"next_token,omitempty"`
+}
+
+func (d *DailyCardiovascularAges) page() ([]DailyCardiovascularAge, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
+// DailyCardiovascularAges gets the daily cardiovascular age estimates within a given timeframe.
+// If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
+//
+//	startDate: endDate - 1 day
+//	endDate: current UTC date
+func (c *Client) DailyCardiovascularAges(ctx context.Context, startDate, endDate, nextToken string) (*DailyCardiovascularAges, *http.Response, error) {
+	path := parametiseDate("v2/usercollection/daily_cardiovascular_age", startDate, endDate, nextToken)
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailyCardiovascularAges
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// DailyCardiovascularAgesAll gets the daily cardiovascular age estimates within a given timeframe,
+// following the pagination tokens until all of the pages have been fetched. If a page fails to be
+// fetched, the data fetched so far is returned along with the error.
+func (c *Client) DailyCardiovascularAgesAll(ctx context.Context, startDate, endDate string) ([]DailyCardiovascularAge, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.DailyCardiovascularAges, startDate, endDate))
+}
+
+// DailyCardiovascularAgesSeq returns an iterator over the daily cardiovascular age estimates within
+// a given timeframe. Each page is fetched when the iteration reaches it, and fetching stops when
+// the iteration does. If a page fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) DailyCardiovascularAgesSeq(ctx context.Context, startDate, endDate string) iter.Seq2[DailyCardiovascularAge, error] {
+	return Seq(ctx, c.maxPages, Pages(c.DailyCardiovascularAges, startDate, endDate))
+}
+
+// DailyCardiovascularAgesWithOptions gets the daily cardiovascular age estimates using typed,
+// validated parameters. An error wrapping ErrInvalidRange is returned, without making a request, if
+// the options are invalid.
+func (c *Client) DailyCardiovascularAgesWithOptions(ctx context.Context, opts *ListOptions) (*DailyCardiovascularAges, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.DailyCardiovascularAges(ctx, start, end, next)
+}
+
+// GetDailyCardiovascularAge gets a single daily cardiovascular age estimate by its ID. An error
+// matching ErrNotFound is returned if there is no daily cardiovascular age estimate with the ID.
+func (c *Client) GetDailyCardiovascularAge(ctx context.Context, id string) (*DailyCardiovascularAge, *http.Response, error) {
+	path, err := documentPath("daily_cardiovascular_age", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailyCardiovascularAge
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
@@ -0,0 +1,127 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dailyCardiovascularAgeCases = []struct {
+	name        string
+	startDate   string
+	endDate     string
+	nextToken   string
+	expectedURL string
+	mock        string
+}{
+	{
+		name:        "get daily cardiovascular ages without specific dates",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_cardiovascular_age",
+		mock:        `testdata/v2/daily_cardiovascular_age.json`,
+	},
+	{
+		name:        "get daily cardiovascular ages with only start date",
+		startDate:   "2020-01-20",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_cardiovascular_age?start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get daily cardiovascular ages with start and end dates",
+		startDate:   "2020-01-20",
+		endDate:     "2020-01-22",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_cardiovascular_age?end_date=2020-01-22&start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get daily cardiovascular ages with next token",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "thisisbase64encodedjson",
+		expectedURL: "/v2/usercollection/daily_cardiovascular_age?next_token=thisisbase64encodedjson",
+		mock:        `{}`, // we don't care about the response here
+	},
+}
+
+func TestDailyCardiovascularAges(t *testing.T) {
+	for _, tc := range dailyCardiovascularAgeCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := tc.mock
+			if strings.HasPrefix(tc.mock, "testdata/") {
+				resp, _ := os.ReadFile(tc.mock)
+				mock = string(resp)
+			}
+			testDailyCardiovascularAges(t, tc.startDate, tc.endDate, tc.nextToken, tc.expectedURL, mock)
+		})
+	}
+}
+
+func testDailyCardiovascularAges(t *testing.T, startDate, endDate, nextToken, expectedURL, mock string) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/daily_cardiovascular_age", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, expectedURL, r.URL.String())
+		fmt.Fprint(w, mock)
+	})
+
+	got, _, err := client.DailyCardiovascularAges(context.Background(), startDate, endDate, nextToken)
+	assert.NoError(t, err, "should not return an error")
+
+	want := &DailyCardiovascularAges{}
+	json.Unmarshal([]byte(mock), want)
+
+	assert.Equal(t, want, got)
+}
+
+func TestDailyCardiovascularAgesDecoding(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/daily_cardiovascular_age", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := os.ReadFile("testdata/v2/daily_cardiovascular_age.json")
+		w.Write(resp)
+	})
+
+	got, _, err := client.DailyCardiovascularAges(context.Background(), "", "", "")
+	assert.NoError(t, err, "should not return an error")
+	assert.Len(t, got.Data, 2)
+	assert.Equal(t, "2024-02-10", got.Data[0].Day)
+	assert.Equal(t, 34, *got.Data[0].VascularAge)
+	assert.Nil(t, got.Data[1].VascularAge, "should leave missing estimates as nil")
+}
+
+func TestGetDailyCardiovascularAge(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/daily_cardiovascular_age/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		if r.URL.Path != "/v2/usercollection/daily_cardiovascular_age/7e4d3c2b" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"detail": "Document not found."}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": "7e4d3c2b", "day": "2024-02-10", "vascular_age": 34}`)
+	})
+
+	got, _, err := client.GetDailyCardiovascularAge(context.Background(), "7e4d3c2b")
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, "7e4d3c2b", got.ID)
+	assert.Equal(t, 34, *got.VascularAge)
+
+	_, _, err = client.GetDailyCardiovascularAge(context.Background(), "missing")
+	assert.True(t, IsNotFound(err), "should return a not found error")
+}
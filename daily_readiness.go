@@ -2,6 +2,7 @@ package oura
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -10,6 +11,7 @@ import (
 type DailyReadiness struct {
 	Contributors              ReadinessContributors `json:"contributors"`
 	Day                       string                `json:"day"`
+	ID                        string                `json:"id"`
 	Score                     *int                  `json:"score,omitempty"`
 	TemperatureDeviation      *float32              `json:"temperature_deviation,omitempty"`
 	TemperatureTrendDeviation *float32              `json:"temperature_trend_deviation,omitempty"`
@@ -23,6 +25,13 @@ type DailyReadinesses struct {
 	NextToken *string `json:"next_token,omitempty"`
 }
 
+func (d *DailyReadinesses) page() ([]DailyReadiness, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
 // ReadinessContributors represents all the contributors to the readiness score.
 type ReadinessContributors struct {
 	// Contribution of cumulative activity balance in range `[1, 100]`.
@@ -65,3 +74,48 @@ func (c *Client) DailyReadinesses(ctx context.Context, startDate, endDate, nextT
 
 	return data, resp, nil
 }
+
+// DailyReadinessesAll gets the daily readiness data within a given timeframe, following the
+// pagination tokens until all of the pages have been fetched. If a page fails to be fetched, the
+// data fetched so far is returned along with the error.
+func (c *Client) DailyReadinessesAll(ctx context.Context, startDate, endDate string) ([]DailyReadiness, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.DailyReadinesses, startDate, endDate))
+}
+
+// DailyReadinessesSeq returns an iterator over the daily readiness data within a given timeframe.
+// Each page is fetched when the iteration reaches it, and fetching stops when the iteration does.
+// If a page fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) DailyReadinessesSeq(ctx context.Context, startDate, endDate string) iter.Seq2[DailyReadiness, error] {
+	return Seq(ctx, c.maxPages, Pages(c.DailyReadinesses, startDate, endDate))
+}
+
+// DailyReadinessesWithOptions gets the daily readiness data using typed, validated parameters. An
+// error wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) DailyReadinessesWithOptions(ctx context.Context, opts *ListOptions) (*DailyReadinesses, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.DailyReadinesses(ctx, start, end, next)
+}
+
+// GetDailyReadiness gets a single daily readiness summary by its ID. An error matching ErrNotFound
+// is returned if there is no daily readiness summary with the ID.
+func (c *Client) GetDailyReadiness(ctx context.Context, id string) (*DailyReadiness, *http.Response, error) {
+	path, err := documentPath("daily_readiness", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailyReadiness
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
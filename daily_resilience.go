@@ -0,0 +1,126 @@
+package oura
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+// ResilienceLevel is the level of resilience to stress.
+type ResilienceLevel string
+
+// The supported resilience levels.
+const (
+	ResilienceLevelLimited     ResilienceLevel = "limited"
+	ResilienceLevelAdequate    ResilienceLevel = "adequate"
+	ResilienceLevelSolid       ResilienceLevel = "solid"
+	ResilienceLevelStrong      ResilienceLevel = "strong"
+	ResilienceLevelExceptional ResilienceLevel = "exceptional"
+)
+
+// DailyResilience represents the resilience data for a single day.
+type DailyResilience struct {
+	// Unique identifier of the document
+	ID string `json:"id"`
+
+	// Contributors to the resilience level
+	Contributors ResilienceContributors `json:"contributors"`
+
+	// The `YYYY-MM-DD` formatted local date indicating when the resilience data was recorded
+	Day string `json:"day"`
+
+	// The resilience level
+	Level ResilienceLevel `json:"level"`
+}
+
+// ResilienceContributors represents all the contributors to the resilience level.
+type ResilienceContributors struct {
+	// Contribution of sleep recovery in range `[0, 100]`.
+	SleepRecovery float32 `json:"sleep_recovery"`
+
+	// Contribution of daytime recovery in range `[0, 100]`.
+	DaytimeRecovery float32 `json:"daytime_recovery"`
+
+	// Contribution of stress in range `[0, 100]`.
+	Stress float32 `json:"stress"`
+}
+
+// DailyResiliences represents the daily resilience data within a given timeframe.
+type DailyResiliences struct {
+	Data []DailyResilience `json:"data"`
+	// Pagination token
+	NextToken *string `json:"next_token,omitempty"`
+}
+
+func (d *DailyResiliences) page() ([]DailyResilience, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
+// DailyResiliences gets the daily resilience data within a given timeframe.
+// If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
+//
+//	startDate: endDate - 1 day
+//	endDate: current UTC date
+func (c *Client) DailyResiliences(ctx context.Context, startDate, endDate, nextToken string) (*DailyResiliences, *http.Response, error) {
+	path := parametiseDate("v2/usercollection/daily_resilience", startDate, endDate, nextToken)
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailyResiliences
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// DailyResiliencesAll gets the daily resilience data within a given timeframe, following the
+// pagination tokens until all of the pages have been fetched. If a page fails to be fetched, the
+// data fetched so far is returned along with the error.
+func (c *Client) DailyResiliencesAll(ctx context.Context, startDate, endDate string) ([]DailyResilience, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.DailyResiliences, startDate, endDate))
+}
+
+// DailyResiliencesSeq returns an iterator over the daily resilience data within a given timeframe.
+// Each page is fetched when the iteration reaches it, and fetching stops when the iteration does.
+// If a page fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) DailyResiliencesSeq(ctx context.Context, startDate, endDate string) iter.Seq2[DailyResilience, error] {
+	return Seq(ctx, c.maxPages, Pages(c.DailyResiliences, startDate, endDate))
+}
+
+// DailyResiliencesWithOptions gets the daily resilience data using typed, validated parameters. An
+// error wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) DailyResiliencesWithOptions(ctx context.Context, opts *ListOptions) (*DailyResiliences, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.DailyResiliences(ctx, start, end, next)
+}
+
+// GetDailyResilience gets a single daily resilience summary by its ID. An error matching
+// ErrNotFound is returned if there is no daily resilience summary with the ID.
+func (c *Client) GetDailyResilience(ctx context.Context, id string) (*DailyResilience, *http.Response, error) {
+	path, err := documentPath("daily_resilience", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailyResilience
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
@@ -0,0 +1,110 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dailyResilienceCases = []struct {
+	name        string
+	startDate   string
+	endDate     string
+	nextToken   string
+	expectedURL string
+	mock        string
+}{
+	{
+		name:        "get daily resilience without specific dates",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_resilience",
+		mock:        `testdata/v2/daily_resilience.json`,
+	},
+	{
+		name:        "get daily resilience with only start date",
+		startDate:   "2020-01-20",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_resilience?start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get daily resilience with start and end dates",
+		startDate:   "2020-01-20",
+		endDate:     "2020-01-22",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_resilience?end_date=2020-01-22&start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get daily resilience with next token",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "thisisbase64encodedjson",
+		expectedURL: "/v2/usercollection/daily_resilience?next_token=thisisbase64encodedjson",
+		mock:        `{}`, // we don't care about the response here
+	},
+}
+
+func TestDailyResiliences(t *testing.T) {
+	for _, tc := range dailyResilienceCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := tc.mock
+			if strings.HasPrefix(tc.mock, "testdata/") {
+				resp, _ := os.ReadFile(tc.mock)
+				mock = string(resp)
+			}
+			testDailyResiliences(t, tc.startDate, tc.endDate, tc.nextToken, tc.expectedURL, mock)
+		})
+	}
+}
+
+func testDailyResiliences(t *testing.T, startDate, endDate, nextToken, expectedURL, mock string) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/daily_resilience", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, expectedURL, r.URL.String())
+		fmt.Fprint(w, mock)
+	})
+
+	got, _, err := client.DailyResiliences(context.Background(), startDate, endDate, nextToken)
+	assert.NoError(t, err, "should not return an error")
+
+	want := &DailyResiliences{}
+	json.Unmarshal([]byte(mock), want)
+
+	assert.Equal(t, want, got)
+}
+
+func TestDailyResiliencesDecoding(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/daily_resilience", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := os.ReadFile("testdata/v2/daily_resilience.json")
+		w.Write(resp)
+	})
+
+	got, _, err := client.DailyResiliences(context.Background(), "", "", "")
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, []DailyResilience{{
+		ID:  "3b1f2a64-7c5e-4a3b-9c1d-2e8f6a4b5c7d",
+		Day: "2024-01-15",
+		Contributors: ResilienceContributors{
+			SleepRecovery:   72.5,
+			DaytimeRecovery: 41.2,
+			Stress:          55.0,
+		},
+		Level: ResilienceLevelSolid,
+	}}, got.Data)
+}
@@ -2,6 +2,7 @@ package oura
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -10,6 +11,7 @@ import (
 type DailySleep struct {
 	Contributors SleepContributors `json:"contributors"`
 	Day          string            `json:"day"`
+	ID           string            `json:"id"`
 	Score        *int              `json:"score,omitempty"`
 	Timestamp    time.Time         `json:"timestamp"`
 }
@@ -20,6 +22,13 @@ type DailySleeps struct {
 	NextToken *string      `json:"next_token,omitempty"`
 }
 
+func (d *DailySleeps) page() ([]DailySleep, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
 // SleepContributors represents all the contributors to the sleep score.
 type SleepContributors struct {
 	// Contribution of deep sleep in range `[1, 100]`.
@@ -64,3 +73,48 @@ func (c *Client) DailySleeps(ctx context.Context, startDate, endDate, nextToken
 
 	return data, resp, nil
 }
+
+// DailySleepsAll gets the daily sleep data within a given timeframe, following the pagination
+// tokens until all of the pages have been fetched. If a page fails to be fetched, the data fetched
+// so far is returned along with the error.
+func (c *Client) DailySleepsAll(ctx context.Context, startDate, endDate string) ([]DailySleep, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.DailySleeps, startDate, endDate))
+}
+
+// DailySleepsSeq returns an iterator over the daily sleep data within a given timeframe. Each page
+// is fetched when the iteration reaches it, and fetching stops when the iteration does. If a page
+// fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) DailySleepsSeq(ctx context.Context, startDate, endDate string) iter.Seq2[DailySleep, error] {
+	return Seq(ctx, c.maxPages, Pages(c.DailySleeps, startDate, endDate))
+}
+
+// DailySleepsWithOptions gets the daily sleep data using typed, validated parameters. An error
+// wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) DailySleepsWithOptions(ctx context.Context, opts *ListOptions) (*DailySleeps, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.DailySleeps(ctx, start, end, next)
+}
+
+// GetDailySleep gets a single daily sleep summary by its ID. An error matching ErrNotFound is
+// returned if there is no daily sleep summary with the ID.
+func (c *Client) GetDailySleep(ctx context.Context, id string) (*DailySleep, *http.Response, error) {
+	path, err := documentPath("daily_sleep", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailySleep
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
@@ -0,0 +1,108 @@
+package oura
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+// DailySpO2 represents the blood oxygen saturation (SpO2) data for a single day.
+type DailySpO2 struct {
+	// Unique identifier of the document
+	ID string `json:"id"`
+
+	// Breathing disturbance index (BDI) calculated using detected SpO2 drops from the average during sleep
+	BreathingDisturbanceIndex *int `json:"breathing_disturbance_index,omitempty"`
+
+	// The `YYYY-MM-DD` formatted local date indicating when the SpO2 data was recorded
+	Day string `json:"day"`
+
+	// The SpO2 percentage value aggregated over a single day
+	SpO2Percentage *SpO2Percentage `json:"spo2_percentage,omitempty"`
+}
+
+// SpO2Percentage represents the aggregated blood oxygen saturation percentage for a day.
+type SpO2Percentage struct {
+	// The average SpO2 percentage during sleep
+	Average float32 `json:"average"`
+}
+
+// DailySpO2s represents the daily SpO2 data within a given timeframe.
+type DailySpO2s struct {
+	Data []DailySpO2 `json:"data"`
+	// Pagination token
+	NextToken *string `json:"next_token,omitempty"`
+}
+
+func (d *DailySpO2s) page() ([]DailySpO2, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
+// DailySpO2s gets the daily SpO2 data within a given timeframe.
+// If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
+//
+//	startDate: endDate - 1 day
+//	endDate: current UTC date
+func (c *Client) DailySpO2s(ctx context.Context, startDate, endDate, nextToken string) (*DailySpO2s, *http.Response, error) {
+	path := parametiseDate("v2/usercollection/daily_spo2", startDate, endDate, nextToken)
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailySpO2s
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// DailySpO2sAll gets the daily SpO2 data within a given timeframe, following the pagination tokens
+// until all of the pages have been fetched. If a page fails to be fetched, the data fetched so far
+// is returned along with the error.
+func (c *Client) DailySpO2sAll(ctx context.Context, startDate, endDate string) ([]DailySpO2, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.DailySpO2s, startDate, endDate))
+}
+
+// DailySpO2sSeq returns an iterator over the daily SpO2 data within a given timeframe. Each page is
+// fetched when the iteration reaches it, and fetching stops when the iteration does. If a page
+// fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) DailySpO2sSeq(ctx context.Context, startDate, endDate string) iter.Seq2[DailySpO2, error] {
+	return Seq(ctx, c.maxPages, Pages(c.DailySpO2s, startDate, endDate))
+}
+
+// DailySpO2sWithOptions gets the daily SpO2 data using typed, validated parameters. An error
+// wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) DailySpO2sWithOptions(ctx context.Context, opts *ListOptions) (*DailySpO2s, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.DailySpO2s(ctx, start, end, next)
+}
+
+// GetDailySpO2 gets a single daily SpO2 summary by its ID. An error matching ErrNotFound is
+// returned if there is no daily SpO2 summary with the ID.
+func (c *Client) GetDailySpO2(ctx context.Context, id string) (*DailySpO2, *http.Response, error) {
+	path, err := documentPath("daily_spo2", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailySpO2
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
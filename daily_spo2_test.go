@@ -0,0 +1,107 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dailySpO2Cases = []struct {
+	name        string
+	startDate   string
+	endDate     string
+	nextToken   string
+	expectedURL string
+	mock        string
+}{
+	{
+		name:        "get daily SpO2 without specific dates",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_spo2",
+		mock:        `testdata/v2/daily_spo2.json`,
+	},
+	{
+		name:        "get daily SpO2 with only start date",
+		startDate:   "2020-01-20",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_spo2?start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get daily SpO2 with start and end dates",
+		startDate:   "2020-01-20",
+		endDate:     "2020-01-22",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_spo2?end_date=2020-01-22&start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get daily SpO2 with next token",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "thisisbase64encodedjson",
+		expectedURL: "/v2/usercollection/daily_spo2?next_token=thisisbase64encodedjson",
+		mock:        `{}`, // we don't care about the response here
+	},
+}
+
+func TestDailySpO2s(t *testing.T) {
+	for _, tc := range dailySpO2Cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := tc.mock
+			if strings.HasPrefix(tc.mock, "testdata/") {
+				resp, _ := os.ReadFile(tc.mock)
+				mock = string(resp)
+			}
+			testDailySpO2s(t, tc.startDate, tc.endDate, tc.nextToken, tc.expectedURL, mock)
+		})
+	}
+}
+
+func testDailySpO2s(t *testing.T, startDate, endDate, nextToken, expectedURL, mock string) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/daily_spo2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, expectedURL, r.URL.String())
+		fmt.Fprint(w, mock)
+	})
+
+	got, _, err := client.DailySpO2s(context.Background(), startDate, endDate, nextToken)
+	assert.NoError(t, err, "should not return an error")
+
+	want := &DailySpO2s{}
+	json.Unmarshal([]byte(mock), want)
+
+	assert.Equal(t, want, got)
+}
+
+func TestDailySpO2sDecoding(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/daily_spo2", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := os.ReadFile("testdata/v2/daily_spo2.json")
+		w.Write(resp)
+	})
+
+	got, _, err := client.DailySpO2s(context.Background(), "", "", "")
+	assert.NoError(t, err, "should not return an error")
+	assert.Len(t, got.Data, 2)
+	assert.Equal(t, "8f9a5221-639e-4a85-81cb-4065ef23f979", got.Data[0].ID)
+	assert.Equal(t, float32(96.125), got.Data[0].SpO2Percentage.Average)
+	assert.Equal(t, 4, *got.Data[0].BreathingDisturbanceIndex)
+	assert.Nil(t, got.Data[1].SpO2Percentage, "should leave missing values as nil")
+	assert.Nil(t, got.Data[1].BreathingDisturbanceIndex, "should leave missing values as nil")
+	assert.Nil(t, got.NextToken)
+}
@@ -0,0 +1,115 @@
+package oura
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+// StressSummary is the summary of the stress experienced during a day.
+type StressSummary string
+
+// The supported stress summaries.
+const (
+	StressSummaryRestored  StressSummary = "restored"
+	StressSummaryNormal    StressSummary = "normal"
+	StressSummaryStressful StressSummary = "stressful"
+)
+
+// DailyStress represents the stress data for a single day.
+type DailyStress struct {
+	// Unique identifier of the document
+	ID string `json:"id"`
+
+	// The `YYYY-MM-DD` formatted local date indicating when the stress data was recorded
+	Day string `json:"day"`
+
+	// Summary of the day's stress
+	DaySummary *StressSummary `json:"day_summary,omitempty"`
+
+	// Time (in seconds) spent in a high recovery zone (bottom quartile of the data)
+	RecoveryHigh *int `json:"recovery_high,omitempty"`
+
+	// Time (in seconds) spent in a high stress zone (top quartile of the data)
+	StressHigh *int `json:"stress_high,omitempty"`
+}
+
+// DailyStresses represents the daily stress data within a given timeframe.
+type DailyStresses struct {
+	Data []DailyStress `json:"data"`
+	// Pagination token
+	NextToken *string `json:"next_token,omitempty"`
+}
+
+func (d *DailyStresses) page() ([]DailyStress, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
+// DailyStresses gets the daily stress data within a given timeframe.
+// If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
+//
+//	startDate: endDate - 1 day
+//	endDate: current UTC date
+func (c *Client) DailyStresses(ctx context.Context, startDate, endDate, nextToken string) (*DailyStresses, *http.Response, error) {
+	path := parametiseDate("v2/usercollection/daily_stress", startDate, endDate, nextToken)
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailyStresses
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// DailyStressesAll gets the daily stress data within a given timeframe, following the pagination
+// tokens until all of the pages have been fetched. If a page fails to be fetched, the data fetched
+// so far is returned along with the error.
+func (c *Client) DailyStressesAll(ctx context.Context, startDate, endDate string) ([]DailyStress, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.DailyStresses, startDate, endDate))
+}
+
+// DailyStressesSeq returns an iterator over the daily stress data within a given timeframe. Each
+// page is fetched when the iteration reaches it, and fetching stops when the iteration does. If a
+// page fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) DailyStressesSeq(ctx context.Context, startDate, endDate string) iter.Seq2[DailyStress, error] {
+	return Seq(ctx, c.maxPages, Pages(c.DailyStresses, startDate, endDate))
+}
+
+// DailyStressesWithOptions gets the daily stress data using typed, validated parameters. An error
+// wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) DailyStressesWithOptions(ctx context.Context, opts *ListOptions) (*DailyStresses, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.DailyStresses(ctx, start, end, next)
+}
+
+// GetDailyStress gets a single daily stress summary by its ID. An error matching ErrNotFound is
+// returned if there is no daily stress summary with the ID.
+func (c *Client) GetDailyStress(ctx context.Context, id string) (*DailyStress, *http.Response, error) {
+	path, err := documentPath("daily_stress", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *DailyStress
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
@@ -0,0 +1,106 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dailyStressCases = []struct {
+	name        string
+	startDate   string
+	endDate     string
+	nextToken   string
+	expectedURL string
+	mock        string
+}{
+	{
+		name:        "get daily stress without specific dates",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_stress",
+		mock:        `testdata/v2/daily_stress.json`,
+	},
+	{
+		name:        "get daily stress with only start date",
+		startDate:   "2020-01-20",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_stress?start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get daily stress with start and end dates",
+		startDate:   "2020-01-20",
+		endDate:     "2020-01-22",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/daily_stress?end_date=2020-01-22&start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get daily stress with next token",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "thisisbase64encodedjson",
+		expectedURL: "/v2/usercollection/daily_stress?next_token=thisisbase64encodedjson",
+		mock:        `{}`, // we don't care about the response here
+	},
+}
+
+func TestDailyStresses(t *testing.T) {
+	for _, tc := range dailyStressCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := tc.mock
+			if strings.HasPrefix(tc.mock, "testdata/") {
+				resp, _ := os.ReadFile(tc.mock)
+				mock = string(resp)
+			}
+			testDailyStresses(t, tc.startDate, tc.endDate, tc.nextToken, tc.expectedURL, mock)
+		})
+	}
+}
+
+func testDailyStresses(t *testing.T, startDate, endDate, nextToken, expectedURL, mock string) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/daily_stress", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, expectedURL, r.URL.String())
+		fmt.Fprint(w, mock)
+	})
+
+	got, _, err := client.DailyStresses(context.Background(), startDate, endDate, nextToken)
+	assert.NoError(t, err, "should not return an error")
+
+	want := &DailyStresses{}
+	json.Unmarshal([]byte(mock), want)
+
+	assert.Equal(t, want, got)
+}
+
+func TestDailyStressesDecoding(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/daily_stress", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := os.ReadFile("testdata/v2/daily_stress.json")
+		w.Write(resp)
+	})
+
+	got, _, err := client.DailyStresses(context.Background(), "", "", "")
+	assert.NoError(t, err, "should not return an error")
+	assert.Len(t, got.Data, 2)
+	assert.Equal(t, StressSummaryStressful, *got.Data[0].DaySummary)
+	assert.Equal(t, 5400, *got.Data[0].StressHigh)
+	assert.Equal(t, 1800, *got.Data[0].RecoveryHigh)
+	assert.Nil(t, got.Data[1].DaySummary, "should leave missing values as nil")
+	assert.Equal(t, "thisisbase64encodedjson", *got.NextToken)
+}
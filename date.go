@@ -0,0 +1,203 @@
+package oura
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidRange is returned, wrapped with a description of the problem, when the dates or
+// datetimes passed to a method are invalid. It is returned before any request is made.
+var ErrInvalidRange = errors.New("oura: invalid date range")
+
+// now returns the current time. It is a variable so tests can control the clock.
+var now = time.Now
+
+// dateLayout is the `YYYY-MM-DD` format used by the Oura API for dates.
+const dateLayout = "2006-01-02"
+
+// Date is a calendar date without a time or timezone, as used by the `day` fields and the date
+// parameters of the Oura API. The zero value represents an unset date.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the date for the given year, month and day.
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// DateOf returns the date on which t falls, in t's location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// ParseDate parses a `YYYY-MM-DD` formatted date.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", s)
+	}
+	return DateOf(t), nil
+}
+
+// String returns the date in `YYYY-MM-DD` format, or an empty string for the zero date.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsZero reports whether the date is unset.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// IsValid reports whether the date is a real calendar date.
+func (d Date) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+// In returns the time at midnight at the start of the date in the given location.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date n days after d. n may be negative.
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// Before reports whether d is before o.
+func (d Date) Before(o Date) bool {
+	return d.In(time.UTC).Before(o.In(time.UTC))
+}
+
+// After reports whether d is after o.
+func (d Date) After(o Date) bool {
+	return o.Before(d)
+}
+
+// MarshalText implements encoding.TextMarshaler using the `YYYY-MM-DD` format.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the `YYYY-MM-DD` format.
+func (d *Date) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*d = Date{}
+		return nil
+	}
+
+	parsed, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// latestDate returns the latest date that has started anywhere in the world. Dates after it are
+// in the future for every user, whatever their timezone.
+func latestDate() Date {
+	return DateOf(now().In(time.FixedZone("UTC+14", 14*60*60)))
+}
+
+// ListOptions holds the parameters of the v2 collection methods taking dates. A zero StartDate or
+// EndDate falls back to Oura's default.
+type ListOptions struct {
+	StartDate Date
+	EndDate   Date
+	NextToken string
+}
+
+// Validate checks that the dates are real dates, that they aren't in the future and that the
+// start date isn't after the end date.
+func (o *ListOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+
+	latest := latestDate()
+	for _, d := range []struct {
+		name string
+		date Date
+	}{{"start", o.StartDate}, {"end", o.EndDate}} {
+		if d.date.IsZero() {
+			continue
+		}
+		if !d.date.IsValid() {
+			return fmt.Errorf("%w: %s date %04d-%02d-%02d is not a valid date", ErrInvalidRange, d.name, d.date.Year, d.date.Month, d.date.Day)
+		}
+		if d.date.After(latest) {
+			return fmt.Errorf("%w: %s date %s is in the future", ErrInvalidRange, d.name, d.date)
+		}
+	}
+
+	if !o.StartDate.IsZero() && !o.EndDate.IsZero() && o.StartDate.After(o.EndDate) {
+		return fmt.Errorf("%w: start date %s is after end date %s", ErrInvalidRange, o.StartDate, o.EndDate)
+	}
+	return nil
+}
+
+// params validates the options and returns them as the string parameters of the collection methods.
+func (o *ListOptions) params() (start, end, next string, err error) {
+	if o == nil {
+		return "", "", "", nil
+	}
+	if err := o.Validate(); err != nil {
+		return "", "", "", err
+	}
+	return o.StartDate.String(), o.EndDate.String(), o.NextToken, nil
+}
+
+// DatetimeListOptions holds the parameters of the v2 collection methods taking datetimes. A zero
+// StartDatetime or EndDatetime falls back to Oura's default.
+type DatetimeListOptions struct {
+	StartDatetime time.Time
+	EndDatetime   time.Time
+	NextToken     string
+}
+
+// Validate checks that the datetimes aren't in the future and that the start datetime isn't after
+// the end datetime.
+func (o *DatetimeListOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+
+	current := now()
+	if o.StartDatetime.After(current) {
+		return fmt.Errorf("%w: start datetime %s is in the future", ErrInvalidRange, o.StartDatetime.Format(time.RFC3339))
+	}
+	if o.EndDatetime.After(current) {
+		return fmt.Errorf("%w: end datetime %s is in the future", ErrInvalidRange, o.EndDatetime.Format(time.RFC3339))
+	}
+	if !o.StartDatetime.IsZero() && !o.EndDatetime.IsZero() && o.StartDatetime.After(o.EndDatetime) {
+		return fmt.Errorf("%w: start datetime %s is after end datetime %s", ErrInvalidRange,
+			o.StartDatetime.Format(time.RFC3339), o.EndDatetime.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// params validates the options and returns them as the string parameters of the collection methods.
+func (o *DatetimeListOptions) params() (start, end, next string, err error) {
+	if o == nil {
+		return "", "", "", nil
+	}
+	if err := o.Validate(); err != nil {
+		return "", "", "", err
+	}
+	if !o.StartDatetime.IsZero() {
+		start = o.StartDatetime.Format(time.RFC3339)
+	}
+	if !o.EndDatetime.IsZero() {
+		end = o.EndDatetime.Format(time.RFC3339)
+	}
+	return start, end, o.NextToken, nil
+}
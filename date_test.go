@@ -0,0 +1,130 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// freezeTime sets the package clock to t for the duration of the test.
+func freezeTime(t *testing.T, at time.Time) {
+	t.Helper()
+	orig := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = orig })
+}
+
+func TestDate(t *testing.T) {
+	d, err := ParseDate("2022-03-20")
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, NewDate(2022, time.March, 20), d)
+	assert.Equal(t, "2022-03-20", d.String())
+	assert.True(t, d.IsValid())
+	assert.Equal(t, NewDate(2022, time.April, 1), d.AddDays(12))
+	assert.Equal(t, NewDate(2022, time.February, 28), d.AddDays(-20))
+	assert.True(t, d.Before(d.AddDays(1)))
+	assert.True(t, d.After(d.AddDays(-1)))
+	assert.Equal(t, time.Date(2022, 3, 20, 0, 0, 0, 0, time.UTC), d.In(time.UTC))
+	assert.Equal(t, d, DateOf(time.Date(2022, 3, 20, 23, 59, 0, 0, time.UTC)))
+
+	assert.Equal(t, "", Date{}.String(), "should format the zero date as an empty string")
+	assert.False(t, NewDate(2022, time.February, 30).IsValid(), "should reject impossible dates")
+
+	for _, s := range []string{"2022-3-20", "20/03/2022", "2022-03-20T00:00:00Z", "2022-02-30", ""} {
+		_, err := ParseDate(s)
+		assert.Error(t, err, "should not parse %q", s)
+	}
+}
+
+func TestDateJSON(t *testing.T) {
+	var v struct {
+		Day Date `json:"day"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"day": "2022-03-20"}`), &v))
+	assert.Equal(t, NewDate(2022, time.March, 20), v.Day)
+
+	out, err := json.Marshal(v)
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, `{"day":"2022-03-20"}`, string(out))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"day": "20 March"}`), &v), "should reject invalid dates")
+}
+
+func TestListOptionsValidate(t *testing.T) {
+	freezeTime(t, time.Date(2022, 3, 20, 12, 0, 0, 0, time.UTC))
+
+	cases := []struct {
+		name string
+		opts *ListOptions
+		err  string
+	}{
+		{"nil options", nil, ""},
+		{"no dates", &ListOptions{}, ""},
+		{"valid range", &ListOptions{StartDate: NewDate(2022, 3, 1), EndDate: NewDate(2022, 3, 20)}, ""},
+		{"same start and end", &ListOptions{StartDate: NewDate(2022, 3, 1), EndDate: NewDate(2022, 3, 1)}, ""},
+		{"tomorrow in UTC is today somewhere", &ListOptions{EndDate: NewDate(2022, 3, 21)}, ""},
+		{"swapped range", &ListOptions{StartDate: NewDate(2022, 3, 5), EndDate: NewDate(2022, 3, 1)}, "oura: invalid date range: start date 2022-03-05 is after end date 2022-03-01"},
+		{"future start", &ListOptions{StartDate: NewDate(2022, 4, 1)}, "oura: invalid date range: start date 2022-04-01 is in the future"},
+		{"future end", &ListOptions{StartDate: NewDate(2022, 3, 1), EndDate: NewDate(2023, 3, 1)}, "oura: invalid date range: end date 2023-03-01 is in the future"},
+		{"impossible date", &ListOptions{StartDate: NewDate(2022, 2, 30)}, "oura: invalid date range: start date 2022-02-30 is not a valid date"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			if tc.err == "" {
+				assert.NoError(t, err, "should not return an error")
+				return
+			}
+			assert.EqualError(t, err, tc.err)
+			assert.ErrorIs(t, err, ErrInvalidRange)
+		})
+	}
+}
+
+func TestDatetimeListOptionsValidate(t *testing.T) {
+	current := time.Date(2022, 3, 20, 12, 0, 0, 0, time.UTC)
+	freezeTime(t, current)
+
+	assert.NoError(t, (&DatetimeListOptions{StartDatetime: current.Add(-time.Hour), EndDatetime: current}).Validate())
+	assert.EqualError(t, (&DatetimeListOptions{StartDatetime: current, EndDatetime: current.Add(-time.Hour)}).Validate(),
+		"oura: invalid date range: start datetime 2022-03-20T12:00:00Z is after end datetime 2022-03-20T11:00:00Z")
+	assert.EqualError(t, (&DatetimeListOptions{EndDatetime: current.Add(time.Hour)}).Validate(),
+		"oura: invalid date range: end datetime 2022-03-20T13:00:00Z is in the future")
+}
+
+func TestWithOptions(t *testing.T) {
+	freezeTime(t, time.Date(2022, 3, 20, 12, 0, 0, 0, time.UTC))
+
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var requested []string
+	mux.HandleFunc("/v2/usercollection/", func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.String())
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.DailySleepsWithOptions(ctx, &ListOptions{StartDate: NewDate(2022, 3, 1), EndDate: NewDate(2022, 3, 2), NextToken: "abc"})
+	assert.NoError(t, err, "should not return an error")
+	_, _, err = client.WorkoutsWithOptions(ctx, nil)
+	assert.NoError(t, err, "should not return an error")
+	_, _, err = client.HeartratesWithOptions(ctx, &DatetimeListOptions{StartDatetime: time.Date(2022, 3, 1, 0, 0, 0, 0, time.FixedZone("", 3600))})
+	assert.NoError(t, err, "should not return an error")
+
+	_, resp, err := client.SleepsWithOptions(ctx, &ListOptions{StartDate: NewDate(2022, 3, 5), EndDate: NewDate(2022, 3, 1)})
+	assert.ErrorIs(t, err, ErrInvalidRange)
+	assert.Nil(t, resp, "should not make a request")
+
+	assert.Equal(t, []string{
+		"/v2/usercollection/daily_sleep?end_date=2022-03-02&next_token=abc&start_date=2022-03-01",
+		"/v2/usercollection/workout",
+		"/v2/usercollection/heartrate?start_datetime=2022-03-01T00%3A00%3A00%2B01%3A00",
+	}, requested)
+}
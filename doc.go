@@ -8,7 +8,7 @@ Usage:
 Construct a new Oura client, then call various methods on the API to access
 different functions of the Oura API. For example:
 
-	client := oura.NewClient(nil)
+	client, err := oura.NewClient(nil)
 
 	// retrieve the user information for the current user using the v1 API
 	user, _, err := client.GetUserInfo(ctx, nil)
@@ -22,15 +22,120 @@ be a personal access token or a full OAuth2 access token:
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	client := oura.NewClient(tc)
+	client, err := oura.NewClient(tc)
 	// retrieve the user information for the current user using the v2 API
 	info, _, err := client.PersonalInfo(ctx, nil)
 
+The client can be configured by passing options to NewClient:
+
+	client, err := oura.NewClient(tc,
+		oura.WithUserAgent("my-app"),
+		oura.WithTimeout(30*time.Second),
+	)
+
+The v2 collection methods take dates as `YYYY-MM-DD` strings and datetimes as
+RFC 3339 strings. Each also has a variant ending in WithOptions that takes typed
+dates and checks them before making a request, returning an error if a date is
+in the future or the range is the wrong way round:
+
+	opts := &oura.ListOptions{StartDate: oura.NewDate(2022, time.March, 1), EndDate: oura.NewDate(2022, time.March, 31)}
+	sleeps, _, err := client.DailySleepsWithOptions(ctx, opts)
+
+The v2 collection methods return a single page of results along with a token for
+the next page. To fetch every page in one call, use the methods ending in All:
+
+	sleeps, err := client.DailySleepsAll(ctx, "2022-01-01", "2022-03-31")
+
+To stream the results instead, without holding them all in memory, use the methods
+ending in Seq. Each page is fetched as the loop reaches it:
+
+	for hr, err := range client.HeartratesSeq(ctx, start, end) {
+		if err != nil {
+			return err
+		}
+		fmt.Println(hr.Timestamp, hr.Bpm)
+	}
+
+Long fetches can record their progress in a CheckpointStore so that a later run
+continues where a failed one stopped:
+
+	store, err := oura.NewFileCheckpointStore("checkpoints")
+	key := oura.CheckpointKey{Collection: "heartrate", UserID: userID}
+	hrs, err := oura.Resume(ctx, store, key, client.Heartrates, start, end, 0)
+
+To try the library without a real user's data, use sandbox mode. All v2 collection
+methods then return sample data from Oura's sandbox:
+
+	client, err := oura.NewClient(tc, oura.WithSandbox())
+
+Failed API calls return an *APIError, which can be tested with helpers such as
+IsNotFound and IsRateLimited. When reading a collection is forbidden, possibly
+because the token is missing a scope, the *APIError is wrapped in a *ScopeError
+naming the scope, and Capabilities reports which collections the token can
+read. Requests that fail with a transient error can be retried automatically by
+setting a retry policy on the client:
+
+	client, err := oura.NewClient(tc, oura.WithRetryPolicy(oura.DefaultRetryPolicy()))
+
+To stay within Oura's request budget when making many requests in parallel, set a
+rate limiter on the client. The same limiter can be shared by all clients using the
+same access token:
+
+	limiter := oura.NewRateLimiter(oura.DefaultRateLimit, oura.DefaultRateLimitPeriod)
+	client, err := oura.NewClient(tc, oura.WithRateLimiter(limiter))
+
 This library supports both v1 and v2 of the Oura API. Function names are in
 the plural form, where appropriate, with the v1 API calls prefixed with `Get`.
 For example, `GetActivities` queries the v1 API, and `DailyActivities` queries
 the v2 API. `GetUserInfo` queries the v1 API and `PersonalInfo` queries the v2 API.
 
+The v2 API methods fetching a single document by its ID are in the singular form
+and prefixed with `Get`, such as `GetDailyActivity` and `GetWorkout`. They return
+an error matching `ErrNotFound` if there is no document with the ID.
+
+Oura's refresh tokens can only be used once, so a refreshed token must be saved
+before the old one is lost. StoredTokenSource loads a user's token from a
+TokenStore, such as a FileTokenStore, and saves the token back each time it's
+refreshed:
+
+	store, err := oura.NewEncryptedFileTokenStore(dir, passphrase)
+	ts, err := oura.StoredTokenSource(ctx, oura.OAuthConfig(clientID, clientSecret, redirectURL), store, userID)
+	client, err := oura.NewClient(oauth2.NewClient(ctx, ts))
+
+Applications serving many users can use a ClientPool, which builds and caches a
+client with its own rate limiter for each user, and runs a function for many
+users at once:
+
+	pool := oura.NewClientPool(func(ctx context.Context, userID string) (oauth2.TokenSource, error) {
+		return oura.StoredTokenSource(ctx, cfg, store, userID)
+	})
+	err := pool.ForEach(ctx, userIDs, 10, func(ctx context.Context, userID string, c *oura.Client) error {
+		...
+	})
+
+Webhook subscriptions are managed with a WebhookClient, which authenticates with
+the application's client ID and secret rather than a user's access token:
+
+	wc, err := oura.NewWebhookClient(nil, clientID, clientSecret)
+	sub, _, err := wc.CreateSubscription(ctx, &oura.WebhookSubscriptionRequest{
+		CallbackURL:       "https://example.com/oura",
+		VerificationToken: verificationToken,
+		EventType:         oura.WebhookEventCreate,
+		DataType:          oura.WebhookDataDailySleep,
+	})
+
+Notifications are received by a WebhookHandler mounted at the callback URL. It
+answers Oura's verification challenge, checks the signature of each notification
+with the client secret, and passes each notification, and the changed document if
+ClientFor is set, to the function registered for its data type:
+
+	h := oura.NewWebhookHandler(verificationToken, clientSecret)
+	h.Handle(oura.WebhookDataDailySleep, func(ctx context.Context, event *oura.WebhookEvent, doc interface{}) error {
+		sleep, _ := doc.(*oura.DailySleep)
+		...
+	})
+	http.Handle("/oura", h)
+
 The Oura API documentation is available at https://cloud.ouraring.com/v2/docs.
 */
 package oura
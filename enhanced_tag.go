@@ -0,0 +1,151 @@
+package oura
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// EnhancedTag represents the data returned from the Oura API for a single enhanced tag. Enhanced tags
+// replace the tags returned by Tags.
+type EnhancedTag struct {
+	// Unique identifier of the document
+	ID string `json:"id"`
+
+	// Additional freeform text on the tag
+	Comment *string `json:"comment,omitempty"`
+
+	// The name of the tag if the tag_type_code is `custom`
+	CustomName *string `json:"custom_name,omitempty"`
+
+	// The `YYYY-MM-DD` formatted local date indicating the end day of the tag, if it spans more than one moment
+	EndDay *string `json:"end_day,omitempty"`
+
+	// ISO 8601 formatted local timestamp indicating the end time of the tag, if it spans more than one moment
+	EndTime *time.Time `json:"end_time,omitempty"`
+
+	// The `YYYY-MM-DD` formatted local date indicating the start day of the tag
+	StartDay string `json:"start_day"`
+
+	// ISO 8601 formatted local timestamp indicating the start time of the tag
+	StartTime time.Time `json:"start_time"`
+
+	// The unique code of the selected tag type, `custom` for custom tags
+	TagTypeCode *string `json:"tag_type_code,omitempty"`
+}
+
+// EnhancedTags represents the enhanced tag data within a given timeframe.
+type EnhancedTags struct {
+	Data []EnhancedTag `json:"data"`
+	// Pagination token
+	NextToken *string `json:"next_token,omitempty"`
+}
+
+func (d *EnhancedTags) page() ([]EnhancedTag, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
+// EnhancedTags gets the enhanced tag data within a given timeframe.
+// If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
+//
+//	startDate: endDate - 1 day
+//	endDate: current UTC date
+func (c *Client) EnhancedTags(ctx context.Context, startDate, endDate, nextToken string) (*EnhancedTags, *http.Response, error) {
+	path := parametiseDate("v2/usercollection/enhanced_tag", startDate, endDate, nextToken)
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *EnhancedTags
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// EnhancedTagsAll gets the enhanced tag data within a given timeframe, following the pagination
+// tokens until all of the pages have been fetched. If a page fails to be fetched, the data fetched
+// so far is returned along with the error.
+func (c *Client) EnhancedTagsAll(ctx context.Context, startDate, endDate string) ([]EnhancedTag, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.EnhancedTags, startDate, endDate))
+}
+
+// EnhancedTagsSeq returns an iterator over the enhanced tag data within a given timeframe. Each
+// page is fetched when the iteration reaches it, and fetching stops when the iteration does. If a
+// page fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) EnhancedTagsSeq(ctx context.Context, startDate, endDate string) iter.Seq2[EnhancedTag, error] {
+	return Seq(ctx, c.maxPages, Pages(c.EnhancedTags, startDate, endDate))
+}
+
+// EnhancedTagsWithOptions gets the enhanced tag data using typed, validated parameters. An error
+// wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) EnhancedTagsWithOptions(ctx context.Context, opts *ListOptions) (*EnhancedTags, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.EnhancedTags(ctx, start, end, next)
+}
+
+// GetEnhancedTag gets a single enhanced tag by its ID. An error matching ErrNotFound is returned if
+// there is no enhanced tag with the ID.
+func (c *Client) GetEnhancedTag(ctx context.Context, id string) (*EnhancedTag, *http.Response, error) {
+	path, err := documentPath("enhanced_tag", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *EnhancedTag
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// EnhancedTags converts a tag from the legacy tag collection into enhanced tags, so that old and
+// new tags can be handled the same way. Each of the tag's tags becomes an enhanced tag at the
+// tag's timestamp, with the tag's text as its comment. A tag with only text becomes a single
+// custom enhanced tag named by the text. Each enhanced tag's ID is the tag's ID followed by a colon
+// and its tag type code, such as `abc:tag_generic_alcohol`, so converted tags have distinct IDs
+// that are the same each time the tag is converted.
+func (t Tag) EnhancedTags() []EnhancedTag {
+	base := EnhancedTag{
+		StartDay:  t.Day,
+		StartTime: t.Timestamp,
+	}
+
+	if len(t.Tags) == 0 {
+		if t.Text == nil {
+			return nil
+		}
+		custom := "custom"
+		base.ID = t.ID + ":" + custom
+		base.TagTypeCode = &custom
+		base.CustomName = t.Text
+		return []EnhancedTag{base}
+	}
+
+	base.Comment = t.Text
+	tags := make([]EnhancedTag, 0, len(t.Tags))
+	for _, code := range t.Tags {
+		et := base
+		code := code
+		et.ID = t.ID + ":" + code
+		et.TagTypeCode = &code
+		tags = append(tags, et)
+	}
+	return tags
+}
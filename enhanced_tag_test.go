@@ -0,0 +1,165 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var enhancedTagCases = []struct {
+	name        string
+	startDate   string
+	endDate     string
+	nextToken   string
+	expectedURL string
+	mock        string
+}{
+	{
+		name:        "get enhanced tags without specific dates",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/enhanced_tag",
+		mock:        `testdata/v2/enhanced_tag.json`,
+	},
+	{
+		name:        "get enhanced tags with only start date",
+		startDate:   "2020-01-20",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/enhanced_tag?start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get enhanced tags with start and end dates",
+		startDate:   "2020-01-20",
+		endDate:     "2020-01-22",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/enhanced_tag?end_date=2020-01-22&start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get enhanced tags with next token",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "thisisbase64encodedjson",
+		expectedURL: "/v2/usercollection/enhanced_tag?next_token=thisisbase64encodedjson",
+		mock:        `{}`, // we don't care about the response here
+	},
+}
+
+func TestEnhancedTags(t *testing.T) {
+	for _, tc := range enhancedTagCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := tc.mock
+			if strings.HasPrefix(tc.mock, "testdata/") {
+				resp, _ := os.ReadFile(tc.mock)
+				mock = string(resp)
+			}
+			testEnhancedTags(t, tc.startDate, tc.endDate, tc.nextToken, tc.expectedURL, mock)
+		})
+	}
+}
+
+func testEnhancedTags(t *testing.T, startDate, endDate, nextToken, expectedURL, mock string) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/enhanced_tag", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, expectedURL, r.URL.String())
+		fmt.Fprint(w, mock)
+	})
+
+	got, _, err := client.EnhancedTags(context.Background(), startDate, endDate, nextToken)
+	assert.NoError(t, err, "should not return an error")
+
+	want := &EnhancedTags{}
+	json.Unmarshal([]byte(mock), want)
+
+	assert.Equal(t, want, got)
+}
+
+func TestEnhancedTagsDecoding(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/enhanced_tag", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := os.ReadFile("testdata/v2/enhanced_tag.json")
+		w.Write(resp)
+	})
+
+	got, _, err := client.EnhancedTags(context.Background(), "", "", "")
+	assert.NoError(t, err, "should not return an error")
+	assert.Len(t, got.Data, 2)
+	assert.Equal(t, "tag_generic_caffeine", *got.Data[0].TagTypeCode)
+	assert.Equal(t, "Double espresso", *got.Data[0].Comment)
+	assert.Nil(t, got.Data[0].EndTime, "should leave missing values as nil")
+	assert.Equal(t, "Weighted blanket", *got.Data[1].CustomName)
+	assert.Equal(t, "2023-04-03", *got.Data[1].EndDay)
+	assert.Equal(t, time.Date(2023, 4, 3, 14, 0, 0, 0, time.UTC), got.Data[1].EndTime.UTC())
+}
+
+func TestGetEnhancedTag(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/enhanced_tag/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		if r.URL.Path != "/v2/usercollection/enhanced_tag/5b5b8f3c" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"detail": "Document not found."}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": "5b5b8f3c", "tag_type_code": "tag_generic_caffeine", "start_day": "2023-04-02", "start_time": "2023-04-02T08:15:00-07:00"}`)
+	})
+
+	got, _, err := client.GetEnhancedTag(context.Background(), "5b5b8f3c")
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, "5b5b8f3c", got.ID)
+	assert.Equal(t, "tag_generic_caffeine", *got.TagTypeCode)
+
+	_, _, err = client.GetEnhancedTag(context.Background(), "missing")
+	assert.True(t, IsNotFound(err), "should return a not found error")
+
+	_, _, err = client.GetEnhancedTag(context.Background(), "")
+	assert.EqualError(t, err, "oura: document ID is required")
+}
+
+func TestTagEnhancedTags(t *testing.T) {
+	text := "Need coffee"
+	ts := time.Date(2021, 1, 1, 1, 2, 3, 0, time.FixedZone("", -8*60*60))
+	code := func(s string) *string { return &s }
+
+	t.Run("tag with tags and text", func(tc *testing.T) {
+		tag := Tag{ID: "tag-1", Day: "2021-01-01", Tags: []string{"tag_generic_nocaffeine", "tag_generic_alcohol"}, Text: &text, Timestamp: ts}
+		assert.Equal(tc, []EnhancedTag{
+			{ID: "tag-1:tag_generic_nocaffeine", Comment: &text, StartDay: "2021-01-01", StartTime: ts, TagTypeCode: code("tag_generic_nocaffeine")},
+			{ID: "tag-1:tag_generic_alcohol", Comment: &text, StartDay: "2021-01-01", StartTime: ts, TagTypeCode: code("tag_generic_alcohol")},
+		}, tag.EnhancedTags())
+
+		ids := map[string]bool{}
+		for _, et := range tag.EnhancedTags() {
+			ids[et.ID] = true
+		}
+		assert.Len(tc, ids, len(tag.Tags), "should give each enhanced tag a distinct ID")
+	})
+
+	t.Run("tag with only text", func(tc *testing.T) {
+		tag := Tag{ID: "tag-2", Day: "2021-01-01", Text: &text, Timestamp: ts}
+		assert.Equal(tc, []EnhancedTag{
+			{ID: "tag-2:custom", CustomName: &text, StartDay: "2021-01-01", StartTime: ts, TagTypeCode: code("custom")},
+		}, tag.EnhancedTags())
+	})
+
+	t.Run("empty tag", func(tc *testing.T) {
+		assert.Empty(tc, Tag{Day: "2021-01-01", Timestamp: ts}.EnhancedTags())
+	})
+}
@@ -0,0 +1,126 @@
+package oura
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors that an *APIError matches with errors.Is, depending on the HTTP status code of the response.
+var (
+	// ErrUnauthorized is matched by API errors with a 401 status code.
+	ErrUnauthorized = errors.New("oura: unauthorized")
+
+	// ErrForbidden is matched by API errors with a 403 status code.
+	ErrForbidden = errors.New("oura: forbidden")
+
+	// ErrNotFound is matched by API errors with a 404 status code.
+	ErrNotFound = errors.New("oura: not found")
+
+	// ErrValidation is matched by API errors with a 400 or 422 status code.
+	ErrValidation = errors.New("oura: validation error")
+
+	// ErrRateLimited is matched by API errors with a 429 status code.
+	ErrRateLimited = errors.New("oura: rate limited")
+)
+
+// APIError is returned for any response from the Oura API with a non-2xx status code.
+type APIError struct {
+	// The HTTP status code of the response
+	StatusCode int
+
+	// The error title returned by the API, if any
+	Title string
+
+	// The error detail returned by the API, if any
+	Detail string
+
+	// The HTTP method of the request that failed
+	Method string
+
+	// The URL of the request that failed
+	URL string
+
+	// The raw response body
+	Body []byte
+}
+
+// newAPIError builds an APIError from a non-2xx response and its body. The body is decoded if it
+// is an Oura JSON error document, and kept as-is otherwise.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       body,
+	}
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.URL = resp.Request.URL.String()
+	}
+
+	detail := errorDetail{}
+	if err := json.Unmarshal(body, &detail); err == nil {
+		e.Detail = detail.Detail
+		if detail.Title != nil {
+			e.Title = *detail.Title
+		}
+	}
+
+	return e
+}
+
+func (e *APIError) Error() string {
+	msg := http.StatusText(e.StatusCode)
+	if msg == "" {
+		msg = fmt.Sprintf("HTTP %d", e.StatusCode)
+	}
+	if e.Detail != "" {
+		return msg + ": " + e.Detail
+	}
+	if e.Title != "" {
+		return msg + ": " + e.Title
+	}
+	return msg
+}
+
+// Is reports whether the error matches one of the package's sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target { //nolint:errorlint // We're comparing against our own sentinels.
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// IsUnauthorized reports whether err is an API error caused by missing or invalid credentials.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsForbidden reports whether err is an API error caused by the credentials not granting access.
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsNotFound reports whether err is an API error for a resource that does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsValidationError reports whether err is an API error caused by invalid request parameters.
+func IsValidationError(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsRateLimited reports whether err is an API error caused by exceeding the rate limit.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
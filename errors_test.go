@@ -0,0 +1,129 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var apiErrorCases = []struct {
+	name       string
+	status     int
+	body       string
+	message    string
+	title      string
+	detail     string
+	sentinel   error
+	sentinelFn func(error) bool
+}{
+	{
+		name:       "unauthorized with a JSON body",
+		status:     http.StatusUnauthorized,
+		body:       `{"status": 401, "title": "Unauthorized", "detail": "Invalid access token"}`,
+		message:    `Unauthorized: Invalid access token`,
+		title:      "Unauthorized",
+		detail:     "Invalid access token",
+		sentinel:   ErrUnauthorized,
+		sentinelFn: IsUnauthorized,
+	},
+	{
+		name:       "forbidden with only a title",
+		status:     http.StatusForbidden,
+		body:       `{"status": 403, "title": "Subscription required"}`,
+		message:    `Forbidden: Subscription required (the token may be missing the "daily" scope needed to read daily_sleep)`,
+		title:      "Subscription required",
+		sentinel:   ErrForbidden,
+		sentinelFn: IsForbidden,
+	},
+	{
+		name:       "not found",
+		status:     http.StatusNotFound,
+		body:       `{"detail": "Document not found"}`,
+		message:    "Not Found: Document not found",
+		detail:     "Document not found",
+		sentinel:   ErrNotFound,
+		sentinelFn: IsNotFound,
+	},
+	{
+		name:       "bad request",
+		status:     http.StatusBadRequest,
+		body:       `{"detail": "Start date is greater than end date"}`,
+		message:    "Bad Request: Start date is greater than end date",
+		detail:     "Start date is greater than end date",
+		sentinel:   ErrValidation,
+		sentinelFn: IsValidationError,
+	},
+	{
+		name:       "unprocessable entity",
+		status:     http.StatusUnprocessableEntity,
+		body:       `{"detail": "Invalid date format"}`,
+		message:    "Unprocessable Entity: Invalid date format",
+		detail:     "Invalid date format",
+		sentinel:   ErrValidation,
+		sentinelFn: IsValidationError,
+	},
+	{
+		name:       "rate limited",
+		status:     http.StatusTooManyRequests,
+		body:       `{"detail": "Rate limit exceeded"}`,
+		message:    "Too Many Requests: Rate limit exceeded",
+		detail:     "Rate limit exceeded",
+		sentinel:   ErrRateLimited,
+		sentinelFn: IsRateLimited,
+	},
+	{
+		name:    "HTML body from a proxy",
+		status:  http.StatusBadGateway,
+		body:    `<html><body><h1>502 Bad Gateway</h1></body></html>`,
+		message: "Bad Gateway",
+	},
+	{
+		name:    "empty body",
+		status:  http.StatusServiceUnavailable,
+		body:    ``,
+		message: "Service Unavailable",
+	},
+}
+
+func TestAPIError(t *testing.T) {
+	for _, tc := range apiErrorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, mux, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/v2/usercollection/daily_sleep", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			})
+
+			_, resp, err := client.DailySleeps(context.Background(), "", "", "")
+			assert.Error(t, err, "should return an error")
+			assert.Equal(t, tc.status, resp.StatusCode)
+			assert.EqualError(t, err, tc.message)
+
+			var apiErr *APIError
+			assert.True(t, errors.As(err, &apiErr), "should return an *APIError")
+			assert.Equal(t, tc.status, apiErr.StatusCode)
+			assert.Equal(t, tc.title, apiErr.Title)
+			assert.Equal(t, tc.detail, apiErr.Detail)
+			assert.Equal(t, http.MethodGet, apiErr.Method)
+			assert.Equal(t, resp.Request.URL.String(), apiErr.URL)
+			assert.Equal(t, tc.body, string(apiErr.Body))
+
+			if tc.sentinel != nil {
+				assert.ErrorIs(t, err, tc.sentinel)
+				assert.True(t, tc.sentinelFn(err))
+				assert.True(t, tc.sentinelFn(fmt.Errorf("wrapped: %w", err)), "should match wrapped errors")
+			}
+			for _, sentinel := range []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrValidation, ErrRateLimited} {
+				if sentinel != tc.sentinel { //nolint:errorlint // We're comparing sentinels directly.
+					assert.NotErrorIs(t, err, sentinel)
+				}
+			}
+		})
+	}
+}
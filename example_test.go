@@ -20,7 +20,11 @@ func Example_getUserInfo() { //nolint:testableexamples // This is an example of
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 
 	userInfo, httpResp, err := cl.GetUserInfo(ctx)
 	if err != nil {
@@ -38,7 +42,11 @@ func Example_getSleep() { //nolint:testableexamples // This is an example of how
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 
 	sleepInfo, httpResp, err := cl.GetSleep(ctx, "2021-12-02", "2021-12-03")
 	if err != nil {
@@ -56,7 +64,11 @@ func Example_getActivities() { //nolint:testableexamples // This is an example o
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 
 	activityInfo, httpResp, err := cl.GetActivities(ctx, "2021-12-02", "2021-12-03")
 	if err != nil {
@@ -74,7 +86,11 @@ func Example_dailyActivity() { //nolint:testableexamples // This is an example o
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	dailyActivity, httpResp, err := cl.DailyActivities(ctx, "2022-03-20", "2022-03-22", "")
 	if err != nil {
 		fmt.Println(err)
@@ -91,7 +107,11 @@ func Example_dailyReadiness() { //nolint:testableexamples // This is an example
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	dailyReadiness, httpResp, err := cl.DailyReadinesses(ctx, "2022-03-20", "2022-03-22", "")
 	if err != nil {
 		fmt.Println(err)
@@ -108,7 +128,11 @@ func Example_dailySleep() { //nolint:testableexamples // This is an example of h
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	dailySleeps, httpResp, err := cl.DailySleeps(ctx, "2022-03-20", "2022-03-22", "")
 	if err != nil {
 		fmt.Println(err)
@@ -125,7 +149,11 @@ func Example_heartrate() { //nolint:testableexamples // This is an example of ho
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	heartrate, httpResp, err := cl.Heartrates(ctx, "2022-03-20T00:00:00+00:00", "2022-03-22T00:00:00+00:00", "")
 	if err != nil {
 		fmt.Println(err)
@@ -142,7 +170,11 @@ func Example_personalInfo() { //nolint:testableexamples // This is an example of
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	personalInfo, httpResp, err := cl.PersonalInfo(ctx)
 	if err != nil {
 		fmt.Println(err)
@@ -159,7 +191,11 @@ func Example_session() { //nolint:testableexamples // This is an example of how
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	session, httpResp, err := cl.Sessions(ctx, "2022-03-20", "2022-03-22", "")
 	if err != nil {
 		fmt.Println(err)
@@ -176,7 +212,11 @@ func Example_sleep() { //nolint:testableexamples // This is an example of how to
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	sleeps, httpResp, err := cl.Sleeps(ctx, "2022-03-20", "2022-03-22", "")
 	if err != nil {
 		fmt.Println(err)
@@ -193,7 +233,11 @@ func Example_tag() { //nolint:testableexamples // This is an example of how to u
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	tags, httpResp, err := cl.Tags(ctx, "2022-03-20", "2022-03-22", "")
 	if err != nil {
 		fmt.Println(err)
@@ -210,7 +254,11 @@ func Example_workout() { //nolint:testableexamples // This is an example of how
 	ctx := context.Background()
 	tc := oauth2.NewClient(ctx, ts)
 
-	cl := oura.NewClient(tc)
+	cl, err := oura.NewClient(tc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	tags, httpResp, err := cl.Workouts(ctx, "2022-03-20", "2022-03-22", "")
 	if err != nil {
 		fmt.Println(err)
@@ -1,10 +1,11 @@
 module github.com/lildude/oura
 
-go 1.19
+go 1.23.0
 
 require (
 	github.com/joho/godotenv v1.3.0
 	github.com/stretchr/testify v1.8.0
+	golang.org/x/crypto v0.41.0
 	golang.org/x/oauth2 v0.0.0-20221006150949-b44042a4b9c1
 )
 
@@ -14,7 +15,7 @@ require (
 	github.com/kr/pretty v0.3.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/rogpeppe/go-internal v1.8.0 // indirect
-	golang.org/x/net v0.7.0 // indirect
+	golang.org/x/net v0.42.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
 	google.golang.org/protobuf v1.28.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
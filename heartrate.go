@@ -2,7 +2,13 @@ package oura
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"iter"
 	"net/http"
+	"sort"
+	"sync"
+	"time"
 )
 
 // Heartrate represents the data returned from the Oura API for a single heart rate measurement.
@@ -29,6 +35,13 @@ type Heartrates struct {
 	NextToken *string `json:"next_token,omitempty"`
 }
 
+func (d *Heartrates) page() ([]Heartrate, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
 // Heartrates gets the heart rate data for a specified Oura user within a given timeframe.
 // If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
 //
@@ -49,3 +62,162 @@ func (c *Client) Heartrates(ctx context.Context, startDatetime, endDatetime, nex
 
 	return data, resp, nil
 }
+
+// HeartratesAll gets the heart rate data within a given timeframe, following the pagination tokens
+// until all of the pages have been fetched. If a page fails to be fetched, the data fetched so far
+// is returned along with the error.
+func (c *Client) HeartratesAll(ctx context.Context, startDatetime, endDatetime string) ([]Heartrate, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.Heartrates, startDatetime, endDatetime))
+}
+
+// HeartratesSeq returns an iterator over the heart rate data within a given timeframe. Each page is
+// fetched when the iteration reaches it, and fetching stops when the iteration does. If a page
+// fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) HeartratesSeq(ctx context.Context, startDatetime, endDatetime string) iter.Seq2[Heartrate, error] {
+	return Seq(ctx, c.maxPages, Pages(c.Heartrates, startDatetime, endDatetime))
+}
+
+// HeartratesWithOptions gets the heart rate data using typed, validated parameters. An error
+// wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) HeartratesWithOptions(ctx context.Context, opts *DatetimeListOptions) (*Heartrates, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.Heartrates(ctx, start, end, next)
+}
+
+// MaxHeartrateWindow is the longest timeframe the Oura API accepts in a single heart rate request.
+const MaxHeartrateWindow = 30 * 24 * time.Hour
+
+// HeartratesRange gets all of the heart rate data between two datetimes. Unlike Heartrates, the
+// timeframe may be longer than MaxHeartrateWindow: it is split into windows that the API accepts,
+// and up to parallelism windows are fetched at the same time. The samples are returned in
+// chronological order, with the duplicates at window boundaries removed.
+//
+// If a window fails to be fetched, the remaining windows are cancelled and the samples fetched so
+// far are returned along with the error.
+func (c *Client) HeartratesRange(ctx context.Context, start, end time.Time, parallelism int) ([]Heartrate, error) {
+	if start.IsZero() || end.IsZero() {
+		return nil, fmt.Errorf("%w: start and end datetimes are required", ErrInvalidRange)
+	}
+	opts := &DatetimeListOptions{StartDatetime: start, EndDatetime: end}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	windows := splitWindow(start, end, MaxHeartrateWindow)
+	results := make([][]Heartrate, len(windows))
+	errs := make([]error, len(windows))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallelism)
+	for i, w := range windows {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i], errs[i] = c.HeartratesAll(ctx, w.Start, w.End)
+			if errs[i] != nil {
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	var all []Heartrate
+	for _, r := range results {
+		all = append(all, r...)
+	}
+	return mergeHeartrates(all), firstError(errs)
+}
+
+// splitWindow splits the timeframe from start to end into consecutive windows no longer than max.
+// Consecutive windows share their boundary.
+func splitWindow(start, end time.Time, max time.Duration) []Window {
+	var windows []Window
+	for {
+		wEnd := start.Add(max)
+		if !wEnd.Before(end) {
+			wEnd = end
+		}
+		windows = append(windows, Window{Start: start.Format(time.RFC3339), End: wEnd.Format(time.RFC3339)})
+		if !wEnd.Before(end) {
+			return windows
+		}
+		start = wEnd
+	}
+}
+
+// mergeHeartrates sorts the samples chronologically and removes samples with the same timestamp
+// and source.
+func mergeHeartrates(samples []Heartrate) []Heartrate {
+	type key struct {
+		at     int64
+		raw    string // used when the timestamp can't be parsed
+		source string
+	}
+	type keyed struct {
+		key key
+		hr  Heartrate
+	}
+
+	all := make([]keyed, len(samples))
+	for i, h := range samples {
+		k := key{raw: h.Timestamp, source: h.Source}
+		if t, err := time.Parse(time.RFC3339, h.Timestamp); err == nil {
+			k = key{at: t.UnixNano(), source: h.Source}
+		}
+		all[i] = keyed{key: k, hr: h}
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].key.at != all[j].key.at {
+			return all[i].key.at < all[j].key.at
+		}
+		return all[i].key.raw < all[j].key.raw
+	})
+
+	seen := make(map[key]bool, len(all))
+	merged := make([]Heartrate, 0, len(all))
+	for _, k := range all {
+		if seen[k.key] {
+			continue
+		}
+		seen[k.key] = true
+		merged = append(merged, k.hr)
+	}
+	return merged
+}
+
+// firstError returns the first non-nil error in errs, preferring errors other than a cancelled
+// context, which are usually caused by another error.
+func firstError(errs []error) error {
+	var cancelled error
+	for _, err := range errs {
+		switch {
+		case err == nil:
+		case errors.Is(err, context.Canceled):
+			if cancelled == nil {
+				cancelled = err
+			}
+		default:
+			return err
+		}
+	}
+	return cancelled
+}
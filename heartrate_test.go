@@ -3,9 +3,13 @@ package oura
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -96,3 +100,90 @@ func testHeartrates(t *testing.T, startDate, endDate, nextToken, expectedURL, mo
 
 	assert.ObjectsAreEqual(want, got)
 }
+
+func TestHeartratesRange(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(65 * 24 * time.Hour)
+	freezeTime(t, end.Add(time.Hour))
+
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var windows []string
+	mux.HandleFunc("/v2/usercollection/heartrate", func(w http.ResponseWriter, r *http.Request) {
+		s, _ := time.Parse(time.RFC3339, r.URL.Query().Get("start_datetime"))
+		e, _ := time.Parse(time.RFC3339, r.URL.Query().Get("end_datetime"))
+		assert.LessOrEqual(t, e.Sub(s), MaxHeartrateWindow, "should not request more than the maximum window")
+
+		mu.Lock()
+		windows = append(windows, s.Format("Jan 2")+"-"+e.Format("Jan 2"))
+		mu.Unlock()
+
+		// Return a sample at each end of the window and one in the middle, newest first.
+		mid := s.Add(e.Sub(s) / 2)
+		fmt.Fprintf(w, `{"data": [
+			{"bpm": 60, "source": "awake", "timestamp": %q},
+			{"bpm": 60, "source": "awake", "timestamp": %q},
+			{"bpm": 60, "source": "awake", "timestamp": %q}
+		]}`, e.Format(time.RFC3339), mid.Format(time.RFC3339), s.Format(time.RFC3339))
+	})
+
+	got, err := client.HeartratesRange(context.Background(), start, end, 3)
+	assert.NoError(t, err, "should not return an error")
+
+	assert.ElementsMatch(t, []string{"Jan 1-Jan 31", "Jan 31-Mar 2", "Mar 2-Mar 7"}, windows, "should split the range into windows")
+
+	var timestamps []string
+	for _, hr := range got {
+		timestamps = append(timestamps, hr.Timestamp)
+	}
+	assert.Equal(t, []string{
+		"2022-01-01T00:00:00Z",
+		"2022-01-16T00:00:00Z",
+		"2022-01-31T00:00:00Z",
+		"2022-02-15T00:00:00Z",
+		"2022-03-02T00:00:00Z",
+		"2022-03-04T12:00:00Z",
+		"2022-03-07T00:00:00Z",
+	}, timestamps, "should return the samples in order without duplicates")
+}
+
+func TestHeartratesRangeErrors(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(65 * 24 * time.Hour)
+	freezeTime(t, end.Add(time.Hour))
+
+	t.Run("swapped range", func(tc *testing.T) {
+		client, _, teardown := setup()
+		defer teardown()
+
+		_, err := client.HeartratesRange(context.Background(), end, start, 1)
+		assert.ErrorIs(tc, err, ErrInvalidRange)
+	})
+
+	t.Run("missing end", func(tc *testing.T) {
+		client, _, teardown := setup()
+		defer teardown()
+
+		_, err := client.HeartratesRange(context.Background(), start, time.Time{}, 1)
+		assert.ErrorIs(tc, err, ErrInvalidRange)
+	})
+
+	t.Run("failed window", func(tc *testing.T) {
+		client, mux, teardown := setup()
+		defer teardown()
+
+		mux.HandleFunc("/v2/usercollection/heartrate", func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Query().Get("start_datetime"), "2022-01-31") {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			fmt.Fprintf(w, `{"data": [{"bpm": 60, "source": "awake", "timestamp": %q}]}`, r.URL.Query().Get("start_datetime"))
+		})
+
+		got, err := client.HeartratesRange(context.Background(), start, end, 1)
+		assert.True(tc, errors.As(err, new(*APIError)), "should return the API error of the failed window")
+		assert.Len(tc, got, 1, "should return the samples fetched before the failure")
+	})
+}
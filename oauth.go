@@ -0,0 +1,168 @@
+package oura
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// Endpoint is Oura's OAuth2 endpoint.
+var Endpoint = oauth2.Endpoint{
+	AuthURL:   "https://cloud.ouraring.com/oauth/authorize",
+	TokenURL:  "https://api.ouraring.com/oauth/token",
+	AuthStyle: oauth2.AuthStyleInParams,
+}
+
+// Scope is an OAuth2 scope granting access to part of a user's data.
+type Scope string
+
+// The OAuth2 scopes of the Oura API.
+const (
+	// ScopeEmail grants access to the user's email address.
+	ScopeEmail Scope = "email"
+	// ScopePersonal grants access to the user's personal information, such as their age and weight.
+	ScopePersonal Scope = "personal"
+	// ScopeDaily grants access to the daily summaries of sleep, activity and readiness.
+	ScopeDaily Scope = "daily"
+	// ScopeHeartRate grants access to the heart rate time series.
+	ScopeHeartRate Scope = "heartrate"
+	// ScopeWorkout grants access to the workouts.
+	ScopeWorkout Scope = "workout"
+	// ScopeTag grants access to the tags.
+	ScopeTag Scope = "tag"
+	// ScopeSession grants access to the guided and unguided sessions.
+	ScopeSession Scope = "session"
+	// ScopeSpO2 grants access to the daily SpO2 averages.
+	ScopeSpO2 Scope = "spo2Daily"
+)
+
+// OAuthConfig returns the OAuth2 configuration for an Oura application with the given client ID,
+// secret and redirect URL, requesting the given scopes. The application's default scopes are
+// requested if none are given.
+func OAuthConfig(clientID, clientSecret, redirectURL string, scopes ...Scope) *oauth2.Config {
+	s := make([]string, len(scopes))
+	for i, scope := range scopes {
+		s[i] = string(scope)
+	}
+
+	return &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		Endpoint:     Endpoint,
+		Scopes:       s,
+	}
+}
+
+// AuthorizeLoopback runs the OAuth2 consent flow for command line tools. It listens on the
+// loopback address of cfg's redirect URL, which must be registered with the Oura application, such
+// as http://localhost:8080/callback. It calls open with the URL of Oura's consent page, which
+// should be opened in the user's browser or shown to the user, and waits for the browser to be
+// redirected back with the authorization code. The code is exchanged for a token, which is
+// returned.
+//
+// It returns when the flow completes, the user denies access or the context is done.
+func AuthorizeLoopback(ctx context.Context, cfg *oauth2.Config, open func(authURL string) error) (*oauth2.Token, error) {
+	redirect, err := url.Parse(cfg.RedirectURL)
+	if err != nil {
+		return nil, fmt.Errorf("oura: invalid redirect URL: %w", err)
+	}
+	if redirect.Scheme != "http" || !isLoopback(redirect.Hostname()) || redirect.Port() == "" {
+		return nil, fmt.Errorf("oura: redirect URL is not a loopback address with a port: %q", cfg.RedirectURL)
+	}
+
+	state, err := randomState()
+	if err != nil {
+		return nil, err
+	}
+
+	ln, err := net.Listen("tcp", redirect.Host)
+	if err != nil {
+		return nil, fmt.Errorf("oura: listening for the redirect: %w", err)
+	}
+
+	type result struct {
+		code string
+		err  error
+	}
+	done := make(chan result, 1)
+
+	path := redirect.Path
+	if path == "" {
+		path = "/"
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("state") != state {
+			http.Error(w, "Invalid state.", http.StatusBadRequest)
+			return
+		}
+
+		var res result
+		switch {
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("oura: authorization failed: %s", q.Get("error"))
+			http.Error(w, "Authorization failed. You can close this window.", http.StatusForbidden)
+		case q.Get("code") == "":
+			res.err = errors.New("oura: authorization failed: no code was returned")
+			http.Error(w, "Authorization failed. You can close this window.", http.StatusBadRequest)
+		default:
+			res.code = q.Get("code")
+			fmt.Fprintln(w, "Authorization complete. You can close this window.")
+		}
+
+		select {
+		case done <- res:
+		default:
+		}
+	})
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go srv.Serve(ln) //nolint:errcheck // Serve always returns an error once the server is closed.
+	defer srv.Close()
+
+	if err := open(cfg.AuthCodeURL(state)); err != nil {
+		return nil, fmt.Errorf("oura: opening the consent page: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-done:
+		if res.err != nil {
+			return nil, res.err
+		}
+		tok, err := cfg.Exchange(ctx, res.code)
+		if err != nil {
+			return nil, fmt.Errorf("oura: exchanging the authorization code: %w", err)
+		}
+		return tok, nil
+	}
+}
+
+// isLoopback reports whether host is a loopback address or localhost.
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// randomState returns a random value for the OAuth2 state parameter.
+func randomState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("oura: generating state: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
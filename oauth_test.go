@@ -0,0 +1,132 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func TestOAuthConfig(t *testing.T) {
+	cfg := OAuthConfig("id", "secret", "https://example.com/callback", ScopeDaily, ScopeHeartRate, ScopeSpO2)
+	assert.Equal(t, "https://cloud.ouraring.com/oauth/authorize", cfg.Endpoint.AuthURL)
+	assert.Equal(t, "https://api.ouraring.com/oauth/token", cfg.Endpoint.TokenURL)
+	assert.Equal(t, []string{"daily", "heartrate", "spo2Daily"}, cfg.Scopes)
+
+	u, _ := url.Parse(cfg.AuthCodeURL("xyz"))
+	assert.Equal(t, url.Values{
+		"client_id":     {"id"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"daily heartrate spo2Daily"},
+		"state":         {"xyz"},
+	}, u.Query())
+
+	assert.Empty(t, OAuthConfig("id", "secret", "https://example.com/callback").Scopes)
+}
+
+// loopbackConfig returns a configuration redirecting to a free loopback port and exchanging codes
+// with a test token server.
+func loopbackConfig(t *testing.T) *oauth2.Config {
+	t.Helper()
+	tokens := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "the-code", r.PostForm.Get("code"))
+		assert.Equal(t, "id", r.PostForm.Get("client_id"))
+		assert.Equal(t, "secret", r.PostForm.Get("client_secret"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "access", "refresh_token": "refresh", "token_type": "bearer", "expires_in": 3600}`)
+	}))
+	t.Cleanup(tokens.Close)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("can't listen on a loopback port: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	cfg := OAuthConfig("id", "secret", "http://"+addr+"/callback", ScopeDaily)
+	cfg.Endpoint.TokenURL = tokens.URL
+	return cfg
+}
+
+// followRedirect follows the redirect of the consent page with the given parameters, as Oura would.
+func followRedirect(t *testing.T, authURL string, params url.Values) {
+	t.Helper()
+	u, _ := url.Parse(authURL)
+	q := u.Query()
+	params.Set("state", q.Get("state"))
+
+	go func() {
+		resp, err := http.Get(q.Get("redirect_uri") + "?" + params.Encode())
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+}
+
+func TestAuthorizeLoopback(t *testing.T) {
+	t.Run("exchanges the code for a token", func(t *testing.T) {
+		cfg := loopbackConfig(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		tok, err := AuthorizeLoopback(ctx, cfg, func(authURL string) error {
+			followRedirect(t, authURL, url.Values{"code": {"the-code"}})
+			return nil
+		})
+		assert.NoError(t, err, "should not return an error")
+		assert.Equal(t, "access", tok.AccessToken)
+		assert.Equal(t, "refresh", tok.RefreshToken)
+	})
+
+	t.Run("returns the error when access is denied", func(t *testing.T) {
+		cfg := loopbackConfig(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := AuthorizeLoopback(ctx, cfg, func(authURL string) error {
+			followRedirect(t, authURL, url.Values{"error": {"access_denied"}})
+			return nil
+		})
+		assert.EqualError(t, err, "oura: authorization failed: access_denied")
+	})
+
+	t.Run("ignores redirects with the wrong state", func(t *testing.T) {
+		cfg := loopbackConfig(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		_, err := AuthorizeLoopback(ctx, cfg, func(authURL string) error {
+			u, _ := url.Parse(authURL)
+			resp, err := http.Get(u.Query().Get("redirect_uri") + "?code=the-code&state=forged")
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			resp.Body.Close()
+			return nil
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded, "should keep waiting for a valid redirect")
+	})
+
+	t.Run("returns the error of open", func(t *testing.T) {
+		cfg := loopbackConfig(t)
+		_, err := AuthorizeLoopback(context.Background(), cfg, func(string) error { return errors.New("no browser") })
+		assert.EqualError(t, err, "oura: opening the consent page: no browser")
+	})
+
+	t.Run("rejects redirect URLs that aren't loopback addresses", func(t *testing.T) {
+		for _, u := range []string{"https://example.com/callback", "http://localhost/callback", "https://127.0.0.1:8080/callback"} {
+			_, err := AuthorizeLoopback(context.Background(), OAuthConfig("id", "secret", u), nil)
+			assert.EqualError(t, err, fmt.Sprintf("oura: redirect URL is not a loopback address with a port: %q", u))
+		}
+	})
+}
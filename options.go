@@ -0,0 +1,150 @@
+package oura
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures a Client created by NewClient.
+type Option func(*Client) error
+
+// Logger is used by the client to log retries and other events worth knowing about. It is
+// satisfied by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// WithBaseURL sets the URL of the Oura API used by the client instead of the default BaseURL.
+// The URL must be absolute and have a trailing slash.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("invalid base URL: %w", err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("base URL is not absolute: %q", baseURL)
+		}
+		if !strings.HasSuffix(u.Path, "/") {
+			return fmt.Errorf("base URL does not have a trailing slash: %q", baseURL)
+		}
+
+		c.baseURL = u
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) error {
+		if ua == "" {
+			return errors.New("user agent is empty")
+		}
+
+		c.UserAgent = ua
+		return nil
+	}
+}
+
+// WithTimeout sets the time limit for each attempt at a request made by the client. Each retry
+// of a failed request gets the full time limit again, so use a context deadline to limit the
+// total time taken by a request and its retries. The HTTP client passed to NewClient is copied
+// rather than modified.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d <= 0 {
+			return fmt.Errorf("timeout must be positive: %s", d)
+		}
+
+		hc := *c.client
+		hc.Timeout = d
+		c.client = &hc
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests.
+func WithRetryPolicy(p *RetryPolicy) Option {
+	return func(c *Client) error {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		c.RetryPolicy = p
+		return nil
+	}
+}
+
+// WithRateLimiter sets the rate limiter used to limit the rate of requests made by the client.
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(c *Client) error {
+		if l == nil {
+			return errors.New("rate limiter is nil")
+		}
+
+		c.RateLimiter = l
+		return nil
+	}
+}
+
+// WithSandbox puts the client in sandbox mode, where all requests for v2 user collections are sent
+// to Oura's sandbox collections instead. The sandbox returns realistic sample data without needing
+// a real user's access token.
+func WithSandbox() Option {
+	return func(c *Client) error {
+		c.sandbox = true
+		return nil
+	}
+}
+
+// WithMaxPages sets the maximum number of pages fetched by the methods that fetch every page of a
+// collection, such as DailySleepsAll. It defaults to DefaultMaxPages.
+func WithMaxPages(n int) Option {
+	return func(c *Client) error {
+		if n < 1 {
+			return fmt.Errorf("max pages must be positive: %d", n)
+		}
+
+		c.maxPages = n
+		return nil
+	}
+}
+
+// WithLogger sets the logger used to log retries.
+func WithLogger(l Logger) Option {
+	return func(c *Client) error {
+		if l == nil {
+			return errors.New("logger is nil")
+		}
+
+		c.logger = l
+		return nil
+	}
+}
+
+// Validate checks that the retry policy's settings are consistent.
+func (p *RetryPolicy) Validate() error {
+	switch {
+	case p == nil:
+		return errors.New("retry policy is nil")
+	case p.MaxAttempts < 0:
+		return fmt.Errorf("retry policy max attempts must not be negative: %d", p.MaxAttempts)
+	case p.BaseBackoff < 0 || p.MaxBackoff < 0:
+		return errors.New("retry policy backoffs must not be negative")
+	case p.MaxBackoff > 0 && p.BaseBackoff > p.MaxBackoff:
+		return fmt.Errorf("retry policy base backoff %s is greater than max backoff %s", p.BaseBackoff, p.MaxBackoff)
+	case p.Jitter < 0 || p.Jitter > 1:
+		return fmt.Errorf("retry policy jitter must be in range [0, 1]: %v", p.Jitter)
+	}
+
+	for _, code := range p.RetryStatusCodes {
+		if code < http.StatusContinue || code > 599 {
+			return fmt.Errorf("retry policy has an invalid status code: %d", code)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package oura
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClientOptions(t *testing.T) {
+	t.Run("base URL", func(tc *testing.T) {
+		c, err := NewClient(nil, WithBaseURL("https://sandbox.example.com/api/"))
+		assert.NoError(tc, err, "should not return an error")
+		assert.Equal(tc, "https://sandbox.example.com/api/", c.baseURL.String())
+
+		req, _ := c.NewRequest(context.Background(), "GET", "v2/usercollection/tag", nil)
+		assert.Equal(tc, "https://sandbox.example.com/api/v2/usercollection/tag", req.URL.String())
+
+		other, _ := NewClient(nil)
+		assert.Equal(tc, BaseURL, other.baseURL.String(), "should not affect other clients")
+	})
+
+	t.Run("user agent", func(tc *testing.T) {
+		c, err := NewClient(nil, WithUserAgent("my-app/1.0"))
+		assert.NoError(tc, err, "should not return an error")
+		assert.Equal(tc, "my-app/1.0", c.UserAgent)
+	})
+
+	t.Run("timeout", func(tc *testing.T) {
+		hc := &http.Client{}
+		c, err := NewClient(hc, WithTimeout(5*time.Second))
+		assert.NoError(tc, err, "should not return an error")
+		assert.Equal(tc, 5*time.Second, c.client.Timeout)
+		assert.Equal(tc, time.Duration(0), hc.Timeout, "should not modify the HTTP client passed in")
+		assert.Equal(tc, time.Duration(0), http.DefaultClient.Timeout, "should not modify the default HTTP client")
+	})
+
+	t.Run("retry policy", func(tc *testing.T) {
+		p := DefaultRetryPolicy()
+		c, err := NewClient(nil, WithRetryPolicy(p))
+		assert.NoError(tc, err, "should not return an error")
+		assert.Same(tc, p, c.RetryPolicy)
+	})
+
+	t.Run("rate limiter", func(tc *testing.T) {
+		l := NewRateLimiter(DefaultRateLimit, DefaultRateLimitPeriod)
+		c, err := NewClient(nil, WithRateLimiter(l))
+		assert.NoError(tc, err, "should not return an error")
+		assert.Same(tc, l, c.RateLimiter)
+	})
+
+	t.Run("logger", func(tc *testing.T) {
+		client, mux, teardown := setup()
+		defer teardown()
+
+		var buf bytes.Buffer
+		assert.NoError(tc, WithLogger(log.New(&buf, "", 0))(client))
+		assert.NoError(tc, WithRetryPolicy(testRetryPolicy())(client))
+
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		req, _ := client.NewRequest(context.Background(), "GET", ".", nil)
+		client.do(req, nil)
+		assert.Contains(tc, buf.String(), "oura: retrying GET "+req.URL.String())
+		assert.Contains(tc, buf.String(), "(attempt 2 of 4): Service Unavailable")
+	})
+}
+
+func TestNewClientInvalidOptions(t *testing.T) {
+	cases := []struct {
+		name string
+		opt  Option
+		err  string
+	}{
+		{"base URL without a trailing slash", WithBaseURL("https://example.com/api"), `oura: base URL does not have a trailing slash: "https://example.com/api"`},
+		{"relative base URL", WithBaseURL("api/"), `oura: base URL is not absolute: "api/"`},
+		{"unparseable base URL", WithBaseURL(":"), `oura: invalid base URL: parse ":": missing protocol scheme`},
+		{"empty user agent", WithUserAgent(""), "oura: user agent is empty"},
+		{"zero timeout", WithTimeout(0), "oura: timeout must be positive: 0s"},
+		{"nil retry policy", WithRetryPolicy(nil), "oura: retry policy is nil"},
+		{"negative attempts", WithRetryPolicy(&RetryPolicy{MaxAttempts: -1}), "oura: retry policy max attempts must not be negative: -1"},
+		{"base backoff above max", WithRetryPolicy(&RetryPolicy{BaseBackoff: time.Minute, MaxBackoff: time.Second}), "oura: retry policy base backoff 1m0s is greater than max backoff 1s"},
+		{"jitter out of range", WithRetryPolicy(&RetryPolicy{Jitter: 2}), "oura: retry policy jitter must be in range [0, 1]: 2"},
+		{"invalid status code", WithRetryPolicy(&RetryPolicy{RetryStatusCodes: []int{1000}}), "oura: retry policy has an invalid status code: 1000"},
+		{"nil rate limiter", WithRateLimiter(nil), "oura: rate limiter is nil"},
+		{"nil logger", WithLogger(nil), "oura: logger is nil"},
+		{"zero max pages", WithMaxPages(0), "oura: max pages must be positive: 0"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := NewClient(nil, tc.opt)
+			assert.Nil(t, c, "should not return a client")
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestSandbox(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	assert.NoError(t, WithSandbox()(client))
+
+	var paths []string
+	mux.HandleFunc("/v2/sandbox/usercollection/", func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.String())
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/v2/usercollection/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to the real collection: %s", r.URL)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.DailySleeps(ctx, "2022-03-20", "", "")
+	assert.NoError(t, err)
+	_, _, err = client.Workouts(ctx, "", "", "")
+	assert.NoError(t, err)
+	_, _, err = client.Tags(ctx, "", "", "abc")
+	assert.NoError(t, err)
+	_, _, err = client.Heartrates(ctx, "2022-03-20T00:00:00Z", "", "")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"/v2/sandbox/usercollection/daily_sleep?start_date=2022-03-20",
+		"/v2/sandbox/usercollection/workout",
+		"/v2/sandbox/usercollection/tag?next_token=abc",
+		"/v2/sandbox/usercollection/heartrate?start_datetime=2022-03-20T00%3A00%3A00Z",
+	}, paths)
+}
+
+func TestSandboxPath(t *testing.T) {
+	assert.Equal(t, "v2/sandbox/usercollection/sleep?start_date=2022-03-20", sandboxPath("v2/usercollection/sleep?start_date=2022-03-20"))
+	assert.Equal(t, "/v2/sandbox/usercollection/daily_sleep", sandboxPath("/v2/usercollection/daily_sleep"))
+	assert.Equal(t, "v1/sleep", sandboxPath("v1/sleep"), "should not rewrite v1 paths")
+	assert.Equal(t, "v2/webhook/subscription", sandboxPath("v2/webhook/subscription"), "should not rewrite other v2 paths")
+}
@@ -0,0 +1,98 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// DefaultMaxPages is the maximum number of pages fetched by ListAll when no other limit is given.
+const DefaultMaxPages = 1000
+
+// ErrTooManyPages is returned when a paginated fetch reaches its page limit before running out
+// of pages.
+var ErrTooManyPages = errors.New("oura: too many pages")
+
+// PageFunc fetches a single page of a collection. It is given the pagination token of the page
+// to fetch, which is empty for the first page, and returns the page's data and the token for the
+// next page, if there is one.
+type PageFunc[T any] func(ctx context.Context, nextToken string) ([]T, *string, error)
+
+// ListAll calls fetch repeatedly, following the pagination tokens until there are no more pages,
+// and returns the data from all of the pages. At most maxPages pages are fetched, or
+// DefaultMaxPages if maxPages is not positive.
+//
+// If a page fails to be fetched, the context is cancelled or the page limit is reached, the data
+// fetched so far is returned along with the error.
+func ListAll[T any](ctx context.Context, maxPages int, fetch PageFunc[T]) ([]T, error) {
+	var all []T
+	for item, err := range Seq(ctx, maxPages, fetch) {
+		if err != nil {
+			return all, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
+
+// Seq returns an iterator over the data of all of the pages returned by fetch. Pages are fetched
+// lazily, as the iteration reaches them, and no more pages are fetched once the iteration stops.
+// At most maxPages pages are fetched, or DefaultMaxPages if maxPages is not positive.
+//
+// If a page fails to be fetched, the context is cancelled or the page limit is reached, the error
+// is yielded along with the zero value of T and the iteration ends.
+func Seq[T any](ctx context.Context, maxPages int, fetch PageFunc[T]) iter.Seq2[T, error] {
+	if maxPages <= 0 {
+		maxPages = DefaultMaxPages
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+		next := ""
+		for page := 1; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			if page > maxPages {
+				yield(zero, fmt.Errorf("%w: stopped after %d pages", ErrTooManyPages, maxPages))
+				return
+			}
+
+			data, token, err := fetch(ctx, next)
+			for _, item := range data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if token == nil || *token == "" {
+				return
+			}
+			next = *token
+		}
+	}
+}
+
+// collection is implemented by the responses of the paginated v2 collection methods.
+type collection[T any] interface {
+	page() ([]T, *string)
+}
+
+// Pages returns a PageFunc fetching the pages of a paginated v2 collection method, such as
+// Client.Heartrates, for the given start and end dates.
+func Pages[T any, P collection[T]](list func(ctx context.Context, start, end, next string) (P, *http.Response, error), start, end string) PageFunc[T] {
+	return func(ctx context.Context, next string) ([]T, *string, error) {
+		data, _, err := list(ctx, start, end, next)
+		if err != nil {
+			return nil, nil, err
+		}
+		items, token := data.page()
+		return items, token, nil
+	}
+}
@@ -0,0 +1,254 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedHandler serves n pages of a collection, each holding a single item built by item and a
+// token pointing at the next page.
+func pagedHandler(t *testing.T, n int, item func(page int) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		page := 1
+		if token := r.URL.Query().Get("next_token"); token != "" {
+			fmt.Sscanf(token, "page%d", &page)
+		}
+
+		next := "null"
+		if page < n {
+			next = fmt.Sprintf(`"page%d"`, page+1)
+		}
+		fmt.Fprintf(w, `{"data": [%s], "next_token": %s}`, item(page), next)
+	}
+}
+
+func TestListAll(t *testing.T) {
+	// fetch returns a PageFunc serving the given pages, failing on the page at index failAt.
+	fetch := func(pages [][]int, failAt int) PageFunc[int] {
+		return func(ctx context.Context, next string) ([]int, *string, error) {
+			i := 0
+			if next != "" {
+				fmt.Sscanf(next, "%d", &i)
+			}
+			if i == failAt {
+				return nil, nil, errors.New("page failed")
+			}
+			if i == len(pages)-1 {
+				return pages[i], nil, nil
+			}
+			token := fmt.Sprint(i + 1)
+			return pages[i], &token, nil
+		}
+	}
+	pages := [][]int{{1, 2}, {3}, {4, 5}}
+
+	t.Run("follows tokens to the last page", func(tc *testing.T) {
+		got, err := ListAll(context.Background(), 0, fetch(pages, -1))
+		assert.NoError(tc, err, "should not return an error")
+		assert.Equal(tc, []int{1, 2, 3, 4, 5}, got)
+	})
+
+	t.Run("returns the partial result when a page fails", func(tc *testing.T) {
+		got, err := ListAll(context.Background(), 0, fetch(pages, 2))
+		assert.EqualError(tc, err, "page failed")
+		assert.Equal(tc, []int{1, 2, 3}, got)
+	})
+
+	t.Run("stops at the page limit", func(tc *testing.T) {
+		got, err := ListAll(context.Background(), 2, fetch(pages, -1))
+		assert.ErrorIs(tc, err, ErrTooManyPages)
+		assert.Equal(tc, []int{1, 2, 3}, got)
+	})
+
+	t.Run("stops when the context is cancelled", func(tc *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		got, err := ListAll(ctx, 0, func(ctx context.Context, next string) ([]int, *string, error) {
+			calls++
+			cancel()
+			token := "more"
+			return []int{calls}, &token, nil
+		})
+		assert.ErrorIs(tc, err, context.Canceled)
+		assert.Equal(tc, []int{1}, got)
+	})
+}
+
+func TestCollectionAll(t *testing.T) {
+	dayItem := func(page int) string { return fmt.Sprintf(`{"day": "2022-03-%02d"}`, page) }
+
+	cases := []struct {
+		name string
+		path string
+		item func(page int) string
+		call func(c *Client) (int, error)
+	}{
+		{"daily activities", "/v2/usercollection/daily_activity", dayItem, func(c *Client) (int, error) {
+			got, err := c.DailyActivitiesAll(context.Background(), "2022-03-01", "2022-03-03")
+			return len(got), err
+		}},
+		{"daily sleeps", "/v2/usercollection/daily_sleep", dayItem, func(c *Client) (int, error) {
+			got, err := c.DailySleepsAll(context.Background(), "2022-03-01", "2022-03-03")
+			return len(got), err
+		}},
+		{"daily readinesses", "/v2/usercollection/daily_readiness", dayItem, func(c *Client) (int, error) {
+			got, err := c.DailyReadinessesAll(context.Background(), "2022-03-01", "2022-03-03")
+			return len(got), err
+		}},
+		{"sessions", "/v2/usercollection/session", dayItem, func(c *Client) (int, error) {
+			got, err := c.SessionsAll(context.Background(), "2022-03-01", "2022-03-03")
+			return len(got), err
+		}},
+		{"tags", "/v2/usercollection/tag", dayItem, func(c *Client) (int, error) {
+			got, err := c.TagsAll(context.Background(), "2022-03-01", "2022-03-03")
+			return len(got), err
+		}},
+		{"workouts", "/v2/usercollection/workout", dayItem, func(c *Client) (int, error) {
+			got, err := c.WorkoutsAll(context.Background(), "2022-03-01", "2022-03-03")
+			return len(got), err
+		}},
+		{"sleeps", "/v2/usercollection/sleep", dayItem, func(c *Client) (int, error) {
+			got, err := c.SleepsAll(context.Background(), "2022-03-01", "2022-03-03")
+			return len(got), err
+		}},
+		{"heartrates", "/v2/usercollection/heartrate", func(page int) string {
+			return fmt.Sprintf(`{"bpm": %d, "source": "awake", "timestamp": "2022-03-01T00:00:0%dZ"}`, 60+page, page)
+		}, func(c *Client) (int, error) {
+			got, err := c.HeartratesAll(context.Background(), "2022-03-01T00:00:00Z", "2022-03-02T00:00:00Z")
+			return len(got), err
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, mux, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc(tc.path, pagedHandler(t, 3, tc.item))
+
+			got, err := tc.call(client)
+			assert.NoError(t, err, "should not return an error")
+			assert.Equal(t, 3, got, "should return the data from every page")
+		})
+	}
+}
+
+func TestCollectionAllPageLimit(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	assert.NoError(t, WithMaxPages(2)(client))
+
+	mux.HandleFunc("/v2/usercollection/daily_sleep", pagedHandler(t, 3, func(page int) string {
+		return fmt.Sprintf(`{"day": "2022-03-%02d"}`, page)
+	}))
+
+	got, err := client.DailySleepsAll(context.Background(), "", "")
+	assert.ErrorIs(t, err, ErrTooManyPages)
+	assert.Len(t, got, 2, "should return the pages fetched before the limit")
+}
+
+func TestSeq(t *testing.T) {
+	t.Run("fetches pages lazily and stops when the loop breaks", func(tc *testing.T) {
+		fetched := 0
+		fetch := func(ctx context.Context, next string) ([]int, *string, error) {
+			fetched++
+			token := "more"
+			return []int{fetched * 10, fetched*10 + 1}, &token, nil
+		}
+
+		var got []int
+		for item, err := range Seq(context.Background(), 0, fetch) {
+			assert.NoError(tc, err, "should not yield an error")
+			got = append(got, item)
+			if len(got) == 3 {
+				break
+			}
+		}
+		assert.Equal(tc, []int{10, 11, 20}, got)
+		assert.Equal(tc, 2, fetched, "should only fetch the pages reached by the loop")
+	})
+
+	t.Run("yields the error of a failed page and ends", func(tc *testing.T) {
+		fetch := func(ctx context.Context, next string) ([]int, *string, error) {
+			if next == "" {
+				token := "second"
+				return []int{1}, &token, nil
+			}
+			return nil, nil, errors.New("page failed")
+		}
+
+		var items []int
+		var errs []error
+		for item, err := range Seq(context.Background(), 0, fetch) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			items = append(items, item)
+		}
+		assert.Equal(tc, []int{1}, items)
+		assert.Len(tc, errs, 1, "should yield a single error")
+		assert.EqualError(tc, errs[0], "page failed")
+	})
+}
+
+func TestCollectionSeq(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	handler := pagedHandler(t, 3, func(page int) string {
+		return fmt.Sprintf(`{"bpm": %d, "source": "awake", "timestamp": "2022-03-01T00:00:0%dZ"}`, 60+page, page)
+	})
+	mux.HandleFunc("/v2/usercollection/heartrate", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		handler(w, r)
+	})
+
+	var bpms []int
+	for hr, err := range client.HeartratesSeq(context.Background(), "2022-03-01T00:00:00Z", "2022-03-02T00:00:00Z") {
+		assert.NoError(t, err, "should not yield an error")
+		bpms = append(bpms, hr.Bpm)
+		if hr.Bpm == 62 {
+			break
+		}
+	}
+	assert.Equal(t, []int{61, 62}, bpms)
+	assert.Equal(t, 2, requests, "should stop fetching when the loop breaks")
+
+	seqs := map[string]func() int{
+		"daily_activity":  func() int { return count(client.DailyActivitiesSeq(context.Background(), "", "")) },
+		"daily_sleep":     func() int { return count(client.DailySleepsSeq(context.Background(), "", "")) },
+		"daily_readiness": func() int { return count(client.DailyReadinessesSeq(context.Background(), "", "")) },
+		"session":         func() int { return count(client.SessionsSeq(context.Background(), "", "")) },
+		"tag":             func() int { return count(client.TagsSeq(context.Background(), "", "")) },
+		"workout":         func() int { return count(client.WorkoutsSeq(context.Background(), "", "")) },
+		"sleep":           func() int { return count(client.SleepsSeq(context.Background(), "", "")) },
+	}
+	for collection, seq := range seqs {
+		mux.HandleFunc("/v2/usercollection/"+collection, pagedHandler(t, 2, func(page int) string {
+			return fmt.Sprintf(`{"day": "2022-03-%02d"}`, page)
+		}))
+		assert.Equal(t, 2, seq(), collection+" should yield the data from every page")
+	}
+}
+
+// count returns the number of items yielded by seq, failing on the first error.
+func count[T any](seq iter.Seq2[T, error]) int {
+	n := 0
+	for _, err := range seq {
+		if err != nil {
+			return -1
+		}
+		n++
+	}
+	return n
+}
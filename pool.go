@@ -0,0 +1,241 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// DefaultIdleTimeout is how long a ClientPool keeps a user's client after it was last used.
+const DefaultIdleTimeout = 30 * time.Minute
+
+// TokenLookup returns the token source of a user, such as one returned by StoredTokenSource.
+type TokenLookup func(ctx context.Context, userID string) (oauth2.TokenSource, error)
+
+// ClientPool builds and caches a Client for each user of an application serving several users.
+// Each user's client has its own rate limiter, because Oura's request budget applies to each
+// access token. It is safe for concurrent use.
+//
+// Idle clients are only evicted when Client or EvictIdle is called, so a pool that may stop being
+// used for a while should have EvictIdle called periodically to release their token sources.
+type ClientPool struct {
+	// IdleTimeout is how long a user's client is kept after it was last used. DefaultIdleTimeout
+	// is used if it's zero.
+	IdleTimeout time.Duration
+
+	// NewRateLimiter returns the rate limiter of a new user's client. Each client gets a limiter
+	// for Oura's standard budget if it's nil.
+	NewRateLimiter func() *RateLimiter
+
+	lookup  TokenLookup
+	opts    []Option
+	mu      sync.Mutex
+	clients map[string]*pooledClient
+	now     func() time.Time
+}
+
+type pooledClient struct {
+	client   *Client
+	lastUsed time.Time
+}
+
+// NewClientPool returns a pool building each user's client from the token source returned by
+// lookup, configured with the given options. Any rate limiter set by the options is replaced
+// with one for the user.
+func NewClientPool(lookup TokenLookup, opts ...Option) *ClientPool {
+	return &ClientPool{
+		lookup:  lookup,
+		opts:    opts,
+		clients: make(map[string]*pooledClient),
+		now:     time.Now,
+	}
+}
+
+// Client returns the client of the user, building it if it isn't in the pool. Clients that have
+// been idle for longer than the IdleTimeout are evicted from the pool first.
+func (p *ClientPool) Client(ctx context.Context, userID string) (*Client, error) {
+	if userID == "" {
+		return nil, errors.New("oura: user ID is required")
+	}
+
+	p.mu.Lock()
+	p.evictIdle()
+	if pc, ok := p.clients[userID]; ok {
+		pc.lastUsed = p.now()
+		p.mu.Unlock()
+		return pc.client, nil
+	}
+	p.mu.Unlock()
+
+	// The token is looked up without holding the lock, as it may be slow.
+	c, err := p.build(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pc, ok := p.clients[userID]; ok {
+		// Another call built the client first.
+		pc.lastUsed = p.now()
+		return pc.client, nil
+	}
+	p.clients[userID] = &pooledClient{client: c, lastUsed: p.now()}
+	return c, nil
+}
+
+func (p *ClientPool) build(ctx context.Context, userID string) (*Client, error) {
+	ts, err := p.lookup(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("oura: looking up token for user %q: %w", userID, err)
+	}
+
+	// The token source outlives this call, so it mustn't refresh with the caller's context.
+	c, err := NewClient(oauth2.NewClient(context.Background(), ts), p.opts...)
+	if err != nil {
+		return nil, err
+	}
+	if p.NewRateLimiter != nil {
+		c.RateLimiter = p.NewRateLimiter()
+	} else {
+		c.RateLimiter = NewRateLimiter(DefaultRateLimit, DefaultRateLimitPeriod)
+	}
+	return c, nil
+}
+
+// Evict removes the client of the user from the pool, such as when the user revokes access. It
+// is rebuilt by the next call to Client.
+func (p *ClientPool) Evict(userID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.clients, userID)
+}
+
+// Len returns the number of clients in the pool.
+func (p *ClientPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.clients)
+}
+
+// EvictIdle removes the clients that have been idle for longer than the IdleTimeout, such as from
+// a time.Ticker.
+func (p *ClientPool) EvictIdle() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.evictIdle()
+}
+
+// evictIdle removes the clients that have been idle for longer than the IdleTimeout. The caller
+// must hold p.mu.
+func (p *ClientPool) evictIdle() {
+	timeout := p.IdleTimeout
+	if timeout <= 0 {
+		timeout = DefaultIdleTimeout
+	}
+
+	cutoff := p.now().Add(-timeout)
+	for id, pc := range p.clients {
+		if pc.lastUsed.Before(cutoff) {
+			delete(p.clients, id)
+		}
+	}
+}
+
+// UserErrors maps user IDs to the errors ForEach returned for them.
+type UserErrors map[string]error
+
+func (e UserErrors) Error() string {
+	ids := make([]string, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	msgs := make([]string, len(ids))
+	for i, id := range ids {
+		msgs[i] = fmt.Sprintf("%s: %v", id, e[id])
+	}
+	users := "users"
+	if len(e) == 1 {
+		users = "user"
+	}
+	return fmt.Sprintf("oura: %d %s failed: %s", len(e), users, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of the users, so errors.Is and errors.As match any of them.
+func (e UserErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// ForEach calls fn with the client of each of the users, running up to parallelism calls at once.
+// fn is called once for each user, even if the user is listed more than once. A failure for one
+// user doesn't stop the others. If any calls fail, including getting a user's client, a UserErrors
+// holding the error of each failed user is returned. If the context is done, the users not yet
+// started fail with the context's error.
+func (p *ClientPool) ForEach(ctx context.Context, userIDs []string, parallelism int, fn func(ctx context.Context, userID string, c *Client) error) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs = make(UserErrors)
+	)
+	fail := func(userID string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs[userID] = err
+	}
+
+	sem := make(chan struct{}, parallelism)
+	seen := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		if err := ctx.Err(); err != nil {
+			fail(userID, err)
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			fail(userID, ctx.Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			c, err := p.Client(ctx, userID)
+			if err == nil {
+				err = fn(ctx, userID, c)
+			}
+			if err != nil {
+				fail(userID, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
@@ -0,0 +1,151 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+// poolSetup establishes a test server and a pool whose users' access tokens are their user IDs.
+// The lookup fails for the user "unknown".
+func poolSetup(t *testing.T) (pool *ClientPool, mux *http.ServeMux, lookups *int32) {
+	t.Helper()
+	mux = http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	lookups = new(int32)
+	pool = NewClientPool(func(ctx context.Context, userID string) (oauth2.TokenSource, error) {
+		atomic.AddInt32(lookups, 1)
+		if userID == "unknown" {
+			return nil, errors.New("no token")
+		}
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: userID}), nil
+	}, WithBaseURL(server.URL+"/"))
+
+	return pool, mux, lookups
+}
+
+func TestClientPool(t *testing.T) {
+	pool, _, lookups := poolSetup(t)
+	ctx := context.Background()
+
+	current := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	pool.now = func() time.Time { return current }
+
+	a, err := pool.Client(ctx, "a")
+	assert.NoError(t, err, "should not return an error")
+	again, _ := pool.Client(ctx, "a")
+	assert.Same(t, a, again, "should reuse the user's client")
+	b, _ := pool.Client(ctx, "b")
+	assert.NotSame(t, a, b)
+	assert.NotNil(t, a.RateLimiter)
+	assert.NotSame(t, a.RateLimiter, b.RateLimiter, "should give each user their own rate limiter")
+	assert.Equal(t, int32(2), atomic.LoadInt32(lookups))
+
+	_, err = pool.Client(ctx, "unknown")
+	assert.EqualError(t, err, `oura: looking up token for user "unknown": no token`)
+	_, err = pool.Client(ctx, "")
+	assert.EqualError(t, err, "oura: user ID is required")
+	assert.Equal(t, 2, pool.Len(), "should not cache failed lookups")
+
+	current = current.Add(DefaultIdleTimeout - time.Minute)
+	_, _ = pool.Client(ctx, "a")
+	current = current.Add(2 * time.Minute)
+	assert.Equal(t, 2, pool.Len())
+	again, _ = pool.Client(ctx, "a")
+	assert.Same(t, a, again, "should keep clients that are in use")
+	assert.Equal(t, 1, pool.Len(), "should evict idle clients")
+
+	pool.Evict("a")
+	again, _ = pool.Client(ctx, "a")
+	assert.NotSame(t, a, again, "should rebuild evicted clients")
+
+	current = current.Add(DefaultIdleTimeout + time.Minute)
+	assert.Equal(t, 1, pool.Len())
+	pool.EvictIdle()
+	assert.Equal(t, 0, pool.Len(), "should evict idle clients without a call to Client")
+}
+
+func TestClientPoolRateLimiter(t *testing.T) {
+	pool, _, _ := poolSetup(t)
+	shared := NewRateLimiter(10, time.Second)
+	pool.opts = append(pool.opts, WithRateLimiter(shared))
+	pool.NewRateLimiter = func() *RateLimiter { return NewRateLimiter(1, time.Second) }
+
+	c, err := pool.Client(context.Background(), "a")
+	assert.NoError(t, err, "should not return an error")
+	assert.NotSame(t, shared, c.RateLimiter, "should replace a shared rate limiter")
+	assert.Equal(t, 1, c.RateLimiter.Remaining())
+}
+
+func TestClientPoolForEach(t *testing.T) {
+	pool, mux, _ := poolSetup(t)
+
+	var running, maxRunning int32
+	mux.HandleFunc("/v2/usercollection/personal_info", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if r.Header.Get("Authorization") == "Bearer broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, `{"email": "%s@example.com"}`, r.Header.Get("Authorization")[len("Bearer "):])
+	})
+
+	var mu sync.Mutex
+	emails := map[string]string{}
+	users := []string{"a", "b", "c", "broken", "unknown", "d"}
+	err := pool.ForEach(context.Background(), users, 2, func(ctx context.Context, userID string, c *Client) error {
+		info, _, err := c.PersonalInfo(ctx)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		emails[userID] = *info.Email
+		return nil
+	})
+
+	var userErrs UserErrors
+	assert.True(t, errors.As(err, &userErrs), "should return the errors of each user")
+	assert.Len(t, userErrs, 2)
+	assert.EqualError(t, userErrs["broken"], "Internal Server Error")
+	assert.EqualError(t, userErrs["unknown"], `oura: looking up token for user "unknown": no token`)
+	assert.EqualError(t, err, `oura: 2 users failed: broken: Internal Server Error; unknown: oura: looking up token for user "unknown": no token`)
+
+	assert.Equal(t, map[string]string{"a": "a@example.com", "b": "b@example.com", "c": "c@example.com", "d": "d@example.com"}, emails)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2), "should run at most two calls at once")
+
+	assert.NoError(t, pool.ForEach(context.Background(), []string{"a"}, 0, func(context.Context, string, *Client) error { return nil }))
+
+	var calls int32
+	err = pool.ForEach(context.Background(), []string{"a", "b", "a", "a"}, 4, func(ctx context.Context, userID string, c *Client) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls), "should call fn once for each user")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = pool.ForEach(ctx, []string{"a", "b"}, 1, func(context.Context, string, *Client) error { return nil })
+	assert.ErrorIs(t, err, context.Canceled, "should fail the users that weren't started")
+}
@@ -0,0 +1,139 @@
+package oura
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// The request budget Oura allows for each access token.
+const (
+	DefaultRateLimit       = 5000
+	DefaultRateLimitPeriod = 5 * time.Minute
+)
+
+// RateLimiter is a token bucket limiting the rate of requests made to the Oura API. It is safe for
+// concurrent use, and a single RateLimiter can be shared by several clients using the same access
+// token so that together they stay within the token's request budget.
+//
+// The limiter also tracks the rate limit headers returned by the API, so it slows down when the
+// API reports fewer remaining requests than the bucket holds, and pauses until the reset time
+// when the API reports the budget is used up.
+type RateLimiter struct {
+	mu       sync.Mutex
+	capacity float64
+	rate     float64 // tokens added per second
+	tokens   float64
+	last     time.Time
+	resumeAt time.Time // set when the API asks us to pause
+	now      func() time.Time
+}
+
+// NewRateLimiter returns a rate limiter that allows limit requests per period, with bursts of up
+// to limit requests. Use NewRateLimiter(DefaultRateLimit, DefaultRateLimitPeriod) for Oura's
+// standard budget.
+func NewRateLimiter(limit int, period time.Duration) *RateLimiter {
+	if limit < 1 {
+		limit = 1
+	}
+	if period <= 0 {
+		period = time.Second
+	}
+
+	return &RateLimiter{
+		capacity: float64(limit),
+		rate:     float64(limit) / period.Seconds(),
+		tokens:   float64(limit),
+		last:     time.Now(),
+		now:      time.Now,
+	}
+}
+
+// Wait blocks until a request may be made or the context is done. It returns an error without
+// waiting if the context's deadline would pass before a request is allowed.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	for {
+		wait := l.reserve()
+		if wait == 0 {
+			return nil
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Remaining returns the number of requests that can currently be made without waiting. A nil
+// limiter doesn't limit requests, so it returns math.MaxInt.
+func (l *RateLimiter) Remaining() int {
+	if l == nil {
+		return math.MaxInt
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+	if now.Before(l.resumeAt) {
+		return 0
+	}
+	return int(l.tokens)
+}
+
+// reserve takes a token from the bucket if one is available and returns zero, or returns how
+// long to wait before trying again.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	if now.Before(l.resumeAt) {
+		return l.resumeAt.Sub(now)
+	}
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.capacity {
+			l.tokens = l.capacity
+		}
+		l.last = now
+	}
+}
+
+// update adjusts the limiter from the rate limit headers of an API response.
+func (l *RateLimiter) update(h http.Header) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	if remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining")); err == nil && float64(remaining) < l.tokens {
+		l.tokens = float64(remaining)
+	}
+	if after, ok := retryAfter(h, now); ok && now.Add(after).After(l.resumeAt) {
+		l.tokens = 0
+		l.resumeAt = now.Add(after)
+	}
+}
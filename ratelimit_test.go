@@ -0,0 +1,141 @@
+package oura
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRateLimiter returns a rate limiter whose clock is controlled by the returned function.
+func newTestRateLimiter(limit int, period time.Duration) (*RateLimiter, func(time.Duration)) {
+	now := time.Date(2022, 3, 20, 12, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(limit, period)
+	l.last = now
+	l.now = func() time.Time { return now }
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	l, advance := newTestRateLimiter(2, 2*time.Second)
+
+	assert.Equal(t, time.Duration(0), l.reserve(), "should allow the first request")
+	assert.Equal(t, time.Duration(0), l.reserve(), "should allow a burst up to the limit")
+	assert.Equal(t, time.Second, l.reserve(), "should wait for a token to be added")
+
+	advance(500 * time.Millisecond)
+	assert.Equal(t, 500*time.Millisecond, l.reserve(), "should wait for the rest of the token")
+
+	advance(500 * time.Millisecond)
+	assert.Equal(t, time.Duration(0), l.reserve(), "should allow a request once a token is added")
+
+	advance(time.Hour)
+	assert.Equal(t, 2, l.Remaining(), "should not refill beyond the limit")
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	t.Run("remaining header lowers the budget", func(tc *testing.T) {
+		l, _ := newTestRateLimiter(100, time.Minute)
+		l.update(http.Header{"X-Ratelimit-Remaining": []string{"5"}})
+		assert.Equal(tc, 5, l.Remaining())
+
+		l.update(http.Header{"X-Ratelimit-Remaining": []string{"50"}})
+		assert.Equal(tc, 5, l.Remaining(), "should not raise the budget")
+	})
+
+	t.Run("used up budget pauses until the reset", func(tc *testing.T) {
+		l, advance := newTestRateLimiter(100, time.Minute)
+		l.update(http.Header{
+			"X-Ratelimit-Remaining": []string{"0"},
+			"X-Ratelimit-Reset":     []string{"30"},
+		})
+		assert.Equal(tc, 0, l.Remaining())
+		assert.Equal(tc, 30*time.Second, l.reserve())
+
+		advance(30 * time.Second)
+		assert.Equal(tc, time.Duration(0), l.reserve(), "should allow requests after the reset")
+	})
+
+	t.Run("retry-after header pauses requests", func(tc *testing.T) {
+		l, _ := newTestRateLimiter(100, time.Minute)
+		l.update(http.Header{"Retry-After": []string{"10"}})
+		assert.Equal(tc, 10*time.Second, l.reserve())
+	})
+
+	t.Run("nil limiter is a no-op", func(tc *testing.T) {
+		var l *RateLimiter
+		l.update(http.Header{"Retry-After": []string{"10"}})
+		assert.NoError(tc, l.Wait(context.Background()))
+		assert.Equal(tc, math.MaxInt, l.Remaining())
+	})
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	t.Run("returns when the context deadline would pass", func(tc *testing.T) {
+		l := NewRateLimiter(1, time.Hour)
+		assert.NoError(tc, l.Wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(tc, l.Wait(ctx), context.DeadlineExceeded)
+	})
+
+	t.Run("is shared by clients", func(tc *testing.T) {
+		clientA, muxA, teardownA := setup()
+		defer teardownA()
+		clientB, muxB, teardownB := setup()
+		defer teardownB()
+
+		var mu sync.Mutex
+		var calls int
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			calls++
+			mu.Unlock()
+			fmt.Fprint(w, `{}`)
+		}
+		muxA.HandleFunc("/v2/usercollection/heartrate", handler)
+		muxB.HandleFunc("/v2/usercollection/sleep", handler)
+
+		// Allow 4 requests, then block for an hour.
+		limiter := NewRateLimiter(4, 4*time.Hour)
+		clientA.RateLimiter = limiter
+		clientB.RateLimiter = limiter
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 10)
+		for i := 0; i < 5; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				_, _, err := clientA.Heartrates(ctx, "", "", "")
+				errs <- err
+			}()
+			go func() {
+				defer wg.Done()
+				_, _, err := clientB.Sleeps(ctx, "", "", "")
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		var failed int
+		for err := range errs {
+			if err != nil {
+				assert.ErrorIs(tc, err, context.DeadlineExceeded)
+				failed++
+			}
+		}
+		assert.Equal(tc, 4, calls, "should only send the requests allowed by the shared limiter")
+		assert.Equal(tc, 6, failed, "should fail the requests that would exceed the limit")
+	})
+}
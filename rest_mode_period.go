@@ -0,0 +1,128 @@
+package oura
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// RestModePeriod represents the data returned from the Oura API for a single period of rest mode.
+type RestModePeriod struct {
+	// Unique identifier of the document
+	ID string `json:"id"`
+
+	// The `YYYY-MM-DD` formatted local date indicating when the rest mode period ended, if it has ended
+	EndDay *string `json:"end_day,omitempty"`
+
+	// ISO 8601 formatted local timestamp indicating when the rest mode period ended, if it has ended
+	EndTime *time.Time `json:"end_time,omitempty"`
+
+	// The episodes recorded during the rest mode period
+	Episodes []RestModeEpisode `json:"episodes"`
+
+	// The `YYYY-MM-DD` formatted local date indicating when the rest mode period started
+	StartDay string `json:"start_day"`
+
+	// ISO 8601 formatted local timestamp indicating when the rest mode period started
+	StartTime time.Time `json:"start_time"`
+}
+
+// RestModeEpisode represents a single episode, such as symptoms or a change of plans, recorded
+// during a rest mode period.
+type RestModeEpisode struct {
+	// The tags selected by the user for the episode
+	Tags []string `json:"tags"`
+
+	// ISO 8601 formatted local timestamp indicating when the episode was recorded
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Active reports whether the user was in rest mode at the given time. A period that hasn't ended
+// is active from its start time onwards.
+func (p RestModePeriod) Active(at time.Time) bool {
+	if at.Before(p.StartTime) {
+		return false
+	}
+	return p.EndTime == nil || at.Before(*p.EndTime)
+}
+
+// RestModePeriods represents the rest mode periods within a given timeframe.
+type RestModePeriods struct {
+	Data []RestModePeriod `json:"data"`
+	// Pagination token
+	NextToken *string `json:"next_token,omitempty"`
+}
+
+func (d *RestModePeriods) page() ([]RestModePeriod, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
+// RestModePeriods gets the rest mode periods within a given timeframe.
+// If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
+//
+//	startDate: endDate - 1 day
+//	endDate: current UTC date
+func (c *Client) RestModePeriods(ctx context.Context, startDate, endDate, nextToken string) (*RestModePeriods, *http.Response, error) {
+	path := parametiseDate("v2/usercollection/rest_mode_period", startDate, endDate, nextToken)
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *RestModePeriods
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// RestModePeriodsAll gets the rest mode periods within a given timeframe, following the pagination
+// tokens until all of the pages have been fetched. If a page fails to be fetched, the data fetched
+// so far is returned along with the error.
+func (c *Client) RestModePeriodsAll(ctx context.Context, startDate, endDate string) ([]RestModePeriod, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.RestModePeriods, startDate, endDate))
+}
+
+// RestModePeriodsSeq returns an iterator over the rest mode periods within a given timeframe. Each
+// page is fetched when the iteration reaches it, and fetching stops when the iteration does. If a
+// page fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) RestModePeriodsSeq(ctx context.Context, startDate, endDate string) iter.Seq2[RestModePeriod, error] {
+	return Seq(ctx, c.maxPages, Pages(c.RestModePeriods, startDate, endDate))
+}
+
+// RestModePeriodsWithOptions gets the rest mode periods using typed, validated parameters. An error
+// wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) RestModePeriodsWithOptions(ctx context.Context, opts *ListOptions) (*RestModePeriods, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.RestModePeriods(ctx, start, end, next)
+}
+
+// GetRestModePeriod gets a single rest mode period by its ID. An error matching ErrNotFound is
+// returned if there is no rest mode period with the ID.
+func (c *Client) GetRestModePeriod(ctx context.Context, id string) (*RestModePeriod, *http.Response, error) {
+	path, err := documentPath("rest_mode_period", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *RestModePeriod
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
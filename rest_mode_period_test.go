@@ -0,0 +1,126 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var restModePeriodCases = []struct {
+	name        string
+	startDate   string
+	endDate     string
+	nextToken   string
+	expectedURL string
+	mock        string
+}{
+	{
+		name:        "get rest mode periods without specific dates",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/rest_mode_period",
+		mock:        `testdata/v2/rest_mode_period.json`,
+	},
+	{
+		name:        "get rest mode periods with only start date",
+		startDate:   "2020-01-20",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/rest_mode_period?start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get rest mode periods with start and end dates",
+		startDate:   "2020-01-20",
+		endDate:     "2020-01-22",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/rest_mode_period?end_date=2020-01-22&start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get rest mode periods with next token",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "thisisbase64encodedjson",
+		expectedURL: "/v2/usercollection/rest_mode_period?next_token=thisisbase64encodedjson",
+		mock:        `{}`, // we don't care about the response here
+	},
+}
+
+func TestRestModePeriods(t *testing.T) {
+	for _, tc := range restModePeriodCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := tc.mock
+			if strings.HasPrefix(tc.mock, "testdata/") {
+				resp, _ := os.ReadFile(tc.mock)
+				mock = string(resp)
+			}
+			testRestModePeriods(t, tc.startDate, tc.endDate, tc.nextToken, tc.expectedURL, mock)
+		})
+	}
+}
+
+func testRestModePeriods(t *testing.T, startDate, endDate, nextToken, expectedURL, mock string) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/rest_mode_period", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, expectedURL, r.URL.String())
+		fmt.Fprint(w, mock)
+	})
+
+	got, _, err := client.RestModePeriods(context.Background(), startDate, endDate, nextToken)
+	assert.NoError(t, err, "should not return an error")
+
+	want := &RestModePeriods{}
+	json.Unmarshal([]byte(mock), want)
+
+	assert.Equal(t, want, got)
+}
+
+func TestRestModePeriodsDecoding(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/rest_mode_period", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := os.ReadFile("testdata/v2/rest_mode_period.json")
+		w.Write(resp)
+	})
+
+	got, _, err := client.RestModePeriods(context.Background(), "", "", "")
+	assert.NoError(t, err, "should not return an error")
+	assert.Len(t, got.Data, 2)
+
+	ended := got.Data[0]
+	assert.Equal(t, "2023-02-10", *ended.EndDay)
+	assert.Len(t, ended.Episodes, 2)
+	assert.Equal(t, []string{"tag_generic_sick", "tag_generic_headache"}, ended.Episodes[0].Tags)
+	assert.Equal(t, time.Date(2023, 2, 8, 18, 45, 0, 0, time.UTC), ended.Episodes[1].Timestamp.UTC())
+
+	ongoing := got.Data[1]
+	assert.Nil(t, ongoing.EndTime, "should leave missing values as nil")
+	assert.Empty(t, ongoing.Episodes)
+}
+
+func TestRestModePeriodActive(t *testing.T) {
+	start := time.Date(2023, 2, 7, 7, 55, 0, 0, time.UTC)
+	end := start.Add(72 * time.Hour)
+
+	ended := RestModePeriod{StartTime: start, EndTime: &end}
+	assert.False(t, ended.Active(start.Add(-time.Minute)), "should not be active before the start")
+	assert.True(t, ended.Active(start), "should be active at the start")
+	assert.True(t, ended.Active(end.Add(-time.Minute)), "should be active before the end")
+	assert.False(t, ended.Active(end), "should not be active at the end")
+
+	ongoing := RestModePeriod{StartTime: start}
+	assert.True(t, ongoing.Active(start.Add(365*24*time.Hour)), "should be active until it ends")
+}
@@ -0,0 +1,199 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the client retries failed requests. A nil policy, or one with
+// MaxAttempts less than 2, disables retries.
+type RetryPolicy struct {
+	// The maximum number of attempts for a single request, including the first one
+	MaxAttempts int
+
+	// The backoff before the first retry. It doubles on each subsequent retry.
+	BaseBackoff time.Duration
+
+	// The upper bound on the computed backoff between two attempts
+	MaxBackoff time.Duration
+
+	// The fraction, in range `[0, 1]`, of each backoff that is randomised to spread out retries
+	Jitter float64
+
+	// The HTTP status codes that are retried
+	RetryStatusCodes []int
+
+	// Whether errors sending the request, such as a refused or reset connection, are retried
+	RetryNetworkErrors bool
+
+	// The HTTP methods that are retried. If empty, only idempotent methods are retried:
+	// `GET`, `HEAD`, `OPTIONS`, `PUT` and `DELETE`.
+	Methods []string
+}
+
+// DefaultRetryPolicy returns a retry policy suitable for most uses: up to 4 attempts of
+// idempotent requests that fail with a network error, a 429 or a 5xx gateway error.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		RetryStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryNetworkErrors: true,
+	}
+}
+
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+// shouldRetry reports whether a request that has been attempted the given number of times
+// should be sent again after receiving resp and err.
+func (p *RetryPolicy) shouldRetry(req *http.Request, attempt int, resp *http.Response, err error) bool {
+	if p == nil || attempt >= p.MaxAttempts || err == nil {
+		return false
+	}
+
+	methods := p.Methods
+	if len(methods) == 0 {
+		methods = idempotentMethods
+	}
+	if !containsString(methods, req.Method) {
+		return false
+	}
+
+	if resp == nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return p.RetryNetworkErrors
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, code := range p.RetryStatusCodes {
+		if code == apiErr.StatusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt. A delay requested by the server
+// through the rate limit headers takes precedence when it is longer than the computed backoff.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := time.Duration(float64(p.BaseBackoff) * math.Pow(2, float64(attempt-1)))
+	if p.MaxBackoff > 0 && (wait > p.MaxBackoff || wait < 0) {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		wait -= time.Duration(rand.Float64() * p.Jitter * float64(wait)) //nolint:gosec // Jitter doesn't need a secure random number.
+	}
+
+	if resp != nil {
+		if after, ok := retryAfter(resp.Header, time.Now()); ok && after > wait {
+			wait = after
+		}
+	}
+	return wait
+}
+
+// retryAfter returns the delay requested by the server in the `Retry-After` header, which may be
+// a number of seconds or an HTTP date. If it's not present and the `X-RateLimit-Remaining` header
+// shows the rate limit has been used up, the time until `X-RateLimit-Reset` is returned instead.
+func retryAfter(h http.Header, now time.Time) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(t.Sub(now)), true
+		}
+	}
+
+	if h.Get("X-RateLimit-Remaining") == "0" {
+		if reset, ok := rateLimitReset(h, now); ok {
+			return nonNegative(reset.Sub(now)), true
+		}
+	}
+	return 0, false
+}
+
+// rateLimitReset returns the time the rate limit resets according to the `X-RateLimit-Reset` header.
+// The header may hold either the number of seconds until the reset or the Unix time of the reset.
+func rateLimitReset(h http.Header, now time.Time) (time.Time, bool) {
+	v, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil || v < 0 {
+		return time.Time{}, false
+	}
+	// Anything larger than a year's worth of seconds can only be a Unix timestamp.
+	if v > int64((365 * 24 * time.Hour).Seconds()) {
+		return time.Unix(v, 0), true
+	}
+	return now.Add(time.Duration(v) * time.Second), true
+}
+
+// sleep waits for the given duration or until the context is done. It returns early, without
+// waiting, if the context's deadline would expire before the wait is over.
+func sleep(ctx context.Context, d time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(d).After(deadline) {
+		return context.DeadlineExceeded
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// rewind returns a copy of req that can be sent again, with its body reset to the beginning.
+func rewind(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return r, nil
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,227 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testRetryPolicy returns a retry policy with backoffs short enough for tests.
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.BaseBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	return p
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("retries a GET request until it succeeds", func(tc *testing.T) {
+		client, mux, teardown := setup()
+		defer teardown()
+		client.RetryPolicy = testRetryPolicy()
+
+		var calls int32
+		mux.HandleFunc("/v2/usercollection/daily_activity", func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			fmt.Fprint(w, `{"data": [{"day": "2022-03-20"}]}`)
+		})
+
+		got, resp, err := client.DailyActivities(context.Background(), "", "", "")
+		assert.NoError(tc, err, "should not return an error")
+		assert.Equal(tc, http.StatusOK, resp.StatusCode)
+		assert.Equal(tc, "2022-03-20", got.Data[0].Day)
+		assert.Equal(tc, int32(3), atomic.LoadInt32(&calls), "should make three attempts")
+	})
+
+	t.Run("stops after the maximum number of attempts", func(tc *testing.T) {
+		client, mux, teardown := setup()
+		defer teardown()
+		client.RetryPolicy = testRetryPolicy()
+
+		var calls int32
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		})
+
+		req, _ := client.NewRequest(context.Background(), "GET", ".", nil)
+		resp, err := client.do(req, nil)
+		assert.Equal(tc, http.StatusBadGateway, resp.StatusCode)
+		assert.Error(tc, err, "should return an error")
+		assert.Equal(tc, int32(4), atomic.LoadInt32(&calls), "should make MaxAttempts attempts")
+	})
+
+	t.Run("does not retry without a policy", func(tc *testing.T) {
+		client, mux, teardown := setup()
+		defer teardown()
+
+		var calls int32
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		req, _ := client.NewRequest(context.Background(), "GET", ".", nil)
+		_, err := client.do(req, nil)
+		assert.Error(tc, err, "should return an error")
+		assert.Equal(tc, int32(1), atomic.LoadInt32(&calls), "should make a single attempt")
+	})
+
+	t.Run("does not retry status codes that aren't retryable", func(tc *testing.T) {
+		client, mux, teardown := setup()
+		defer teardown()
+		client.RetryPolicy = testRetryPolicy()
+
+		var calls int32
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		})
+
+		req, _ := client.NewRequest(context.Background(), "GET", ".", nil)
+		_, err := client.do(req, nil)
+		assert.True(tc, IsValidationError(err))
+		assert.Equal(tc, int32(1), atomic.LoadInt32(&calls), "should make a single attempt")
+	})
+
+	t.Run("does not retry non-idempotent methods by default", func(tc *testing.T) {
+		client, mux, teardown := setup()
+		defer teardown()
+		client.RetryPolicy = testRetryPolicy()
+
+		var calls int32
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		req, _ := client.NewRequest(context.Background(), "POST", ".", map[string]string{"a": "b"})
+		_, err := client.do(req, nil)
+		assert.Error(tc, err, "should return an error")
+		assert.Equal(tc, int32(1), atomic.LoadInt32(&calls), "should make a single attempt")
+	})
+
+	t.Run("resends the request body on retry", func(tc *testing.T) {
+		client, mux, teardown := setup()
+		defer teardown()
+		client.RetryPolicy = testRetryPolicy()
+		client.RetryPolicy.Methods = []string{http.MethodPost}
+
+		var calls int32
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(tc, `{"a":"b"}`+"\n", string(body))
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+			}
+		})
+
+		req, _ := client.NewRequest(context.Background(), "POST", ".", map[string]string{"a": "b"})
+		_, err := client.do(req, nil)
+		assert.NoError(tc, err, "should not return an error")
+		assert.Equal(tc, int32(2), atomic.LoadInt32(&calls), "should make two attempts")
+	})
+
+	t.Run("gives up when the context deadline would pass before the next attempt", func(tc *testing.T) {
+		client, mux, teardown := setup()
+		defer teardown()
+		client.RetryPolicy = testRetryPolicy()
+
+		var calls int32
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		start := time.Now()
+		req, _ := client.NewRequest(ctx, "GET", ".", nil)
+		_, err := client.do(req, nil)
+		assert.True(tc, IsRateLimited(err), "should return the rate limit error")
+		assert.Equal(tc, int32(1), atomic.LoadInt32(&calls), "should make a single attempt")
+		assert.Less(tc, time.Since(start), time.Second, "should not wait for the deadline")
+	})
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	p := DefaultRetryPolicy()
+	get, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable}
+	unavailable := &APIError{StatusCode: http.StatusServiceUnavailable}
+	networkErr := errors.New("connection reset by peer")
+
+	assert.True(t, p.shouldRetry(get, 1, resp, unavailable), "should retry a retryable status")
+	assert.False(t, p.shouldRetry(get, 4, resp, unavailable), "should not retry after the last attempt")
+	assert.False(t, p.shouldRetry(get, 1, &http.Response{StatusCode: http.StatusOK}, nil), "should not retry a success")
+	assert.False(t, p.shouldRetry(get, 1, &http.Response{StatusCode: http.StatusOK}, errors.New("bad JSON")), "should not retry decoding errors")
+	assert.True(t, p.shouldRetry(get, 1, nil, networkErr), "should retry network errors")
+	assert.False(t, p.shouldRetry(get, 1, nil, context.Canceled), "should not retry a cancelled context")
+
+	p.RetryNetworkErrors = false
+	assert.False(t, p.shouldRetry(get, 1, nil, networkErr), "should not retry network errors when disabled")
+
+	var nilPolicy *RetryPolicy
+	assert.False(t, nilPolicy.shouldRetry(get, 1, resp, unavailable), "should not retry with a nil policy")
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2022, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name    string
+		headers map[string]string
+		want    time.Duration
+		ok      bool
+	}{
+		{"no headers", map[string]string{}, 0, false},
+		{"retry-after seconds", map[string]string{"Retry-After": "30"}, 30 * time.Second, true},
+		{"retry-after date", map[string]string{"Retry-After": "Sun, 20 Mar 2022 12:01:00 GMT"}, time.Minute, true},
+		{"retry-after date in the past", map[string]string{"Retry-After": "Sun, 20 Mar 2022 11:00:00 GMT"}, 0, true},
+		{"rate limit reset in seconds", map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "90"}, 90 * time.Second, true},
+		{"rate limit reset as a timestamp", map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": fmt.Sprint(now.Add(2 * time.Minute).Unix())}, 2 * time.Minute, true},
+		{"rate limit not used up", map[string]string{"X-RateLimit-Remaining": "10", "X-RateLimit-Reset": "90"}, 0, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := http.Header{}
+			for k, v := range tc.headers {
+				h.Set(k, v)
+			}
+			got, ok := retryAfter(h, now)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	assert.Equal(t, time.Second, p.backoff(1, nil))
+	assert.Equal(t, 2*time.Second, p.backoff(2, nil))
+	assert.Equal(t, 4*time.Second, p.backoff(3, nil))
+	assert.Equal(t, 5*time.Second, p.backoff(4, nil), "should be capped at the maximum backoff")
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"20"}}}
+	assert.Equal(t, 20*time.Second, p.backoff(1, resp), "should honour a longer Retry-After")
+
+	p.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		d := p.backoff(2, nil)
+		assert.True(t, d > time.Second && d <= 2*time.Second, "should stay within the jitter range")
+	}
+}
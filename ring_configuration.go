@@ -0,0 +1,143 @@
+package oura
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RingColor is the color of a ring.
+type RingColor string
+
+// The supported ring colors.
+const (
+	RingColorGlossyBlack  RingColor = "glossy_black"
+	RingColorStealthBlack RingColor = "stealth_black"
+	RingColorRose         RingColor = "rose"
+	RingColorSilver       RingColor = "silver"
+	RingColorGlossyGold   RingColor = "glossy_gold"
+)
+
+// RingDesign is the design of a ring.
+type RingDesign string
+
+// The supported ring designs.
+const (
+	RingDesignHeritage RingDesign = "heritage"
+	RingDesignHorizon  RingDesign = "horizon"
+)
+
+// RingHardwareType is the hardware generation of a ring.
+type RingHardwareType string
+
+// The supported ring hardware generations.
+const (
+	RingHardwareGen1  RingHardwareType = "gen1"
+	RingHardwareGen2  RingHardwareType = "gen2"
+	RingHardwareGen2M RingHardwareType = "gen2m"
+	RingHardwareGen3  RingHardwareType = "gen3"
+)
+
+// RingConfiguration represents the data returned from the Oura API for a single ring used by the user.
+type RingConfiguration struct {
+	// Unique identifier of the document
+	ID string `json:"id"`
+
+	// The color of the ring
+	Color *RingColor `json:"color,omitempty"`
+
+	// The design of the ring
+	Design *RingDesign `json:"design,omitempty"`
+
+	// The firmware version of the ring
+	FirmwareVersion *string `json:"firmware_version,omitempty"`
+
+	// The hardware generation of the ring
+	HardwareType *RingHardwareType `json:"hardware_type,omitempty"`
+
+	// ISO 8601 formatted timestamp indicating when the ring was set up
+	SetUpAt *time.Time `json:"set_up_at,omitempty"`
+
+	// The US size of the ring
+	Size *int `json:"size,omitempty"`
+}
+
+// RingConfigurations represents the configurations of the rings used by the user within a given timeframe.
+type RingConfigurations struct {
+	Data []RingConfiguration `json:"data"`
+	// Pagination token
+	NextToken *string `json:"next_token,omitempty"`
+}
+
+func (d *RingConfigurations) page() ([]RingConfiguration, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
+// RingConfigurations gets the configurations of all of the rings the user has set up.
+func (c *Client) RingConfigurations(ctx context.Context, nextToken string) (*RingConfigurations, *http.Response, error) {
+	path := "v2/usercollection/ring_configuration"
+	if nextToken != "" {
+		path += "?" + url.Values{"next_token": {nextToken}}.Encode()
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *RingConfigurations
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// GetRingConfiguration gets a single ring configuration by its ID. An error matching ErrNotFound is
+// returned if there is no ring configuration with the ID.
+func (c *Client) GetRingConfiguration(ctx context.Context, id string) (*RingConfiguration, *http.Response, error) {
+	path, err := documentPath("ring_configuration", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *RingConfiguration
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// RingConfigurationsAll gets the configurations of all of the rings the user has set up, following
+// the pagination tokens until all of the pages have been fetched. If a page fails to be fetched,
+// the data fetched so far is returned along with the error.
+func (c *Client) RingConfigurationsAll(ctx context.Context) ([]RingConfiguration, error) {
+	return ListAll(ctx, c.maxPages, c.ringConfigurationPages)
+}
+
+// RingConfigurationsSeq returns an iterator over the configurations of all of the rings the user
+// has set up. Each page is fetched when the iteration reaches it, and fetching stops when the
+// iteration does. If a page fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) RingConfigurationsSeq(ctx context.Context) iter.Seq2[RingConfiguration, error] {
+	return Seq(ctx, c.maxPages, c.ringConfigurationPages)
+}
+
+func (c *Client) ringConfigurationPages(ctx context.Context, next string) ([]RingConfiguration, *string, error) {
+	data, _, err := c.RingConfigurations(ctx, next)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, token := data.page()
+	return items, token, nil
+}
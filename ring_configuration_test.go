@@ -0,0 +1,94 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ringConfigurationCases = []struct {
+	name        string
+	nextToken   string
+	expectedURL string
+	mock        string
+}{
+	{
+		name:        "get ring configurations",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/ring_configuration",
+		mock:        `testdata/v2/ring_configuration.json`,
+	},
+	{
+		name:        "get ring configurations with next token",
+		nextToken:   "thisisbase64encodedjson",
+		expectedURL: "/v2/usercollection/ring_configuration?next_token=thisisbase64encodedjson",
+		mock:        `{}`, // we don't care about the response here
+	},
+}
+
+func TestRingConfigurations(t *testing.T) {
+	for _, tc := range ringConfigurationCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := tc.mock
+			if strings.HasPrefix(tc.mock, "testdata/") {
+				resp, _ := os.ReadFile(tc.mock)
+				mock = string(resp)
+			}
+			testRingConfigurations(t, tc.nextToken, tc.expectedURL, mock)
+		})
+	}
+}
+
+func testRingConfigurations(t *testing.T, nextToken, expectedURL, mock string) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/ring_configuration", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, expectedURL, r.URL.String())
+		fmt.Fprint(w, mock)
+	})
+
+	got, _, err := client.RingConfigurations(context.Background(), nextToken)
+	assert.NoError(t, err, "should not return an error")
+
+	want := &RingConfigurations{}
+	json.Unmarshal([]byte(mock), want)
+
+	assert.Equal(t, want, got)
+}
+
+func TestRingConfigurationsDecoding(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/ring_configuration", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := os.ReadFile("testdata/v2/ring_configuration.json")
+		w.Write(resp)
+	})
+
+	got, err := client.RingConfigurationsAll(context.Background())
+	assert.NoError(t, err, "should not return an error")
+	assert.Len(t, got, 2)
+
+	gen3 := got[0]
+	assert.Equal(t, RingHardwareGen3, *gen3.HardwareType)
+	assert.Equal(t, RingColorStealthBlack, *gen3.Color)
+	assert.Equal(t, RingDesignHeritage, *gen3.Design)
+	assert.Equal(t, "2.9.18", *gen3.FirmwareVersion)
+	assert.Equal(t, 10, *gen3.Size)
+	assert.Equal(t, time.Date(2022, 11, 4, 18, 22, 5, 0, time.UTC), gen3.SetUpAt.UTC())
+
+	gen2 := got[1]
+	assert.Equal(t, RingHardwareGen2, *gen2.HardwareType)
+	assert.Equal(t, RingDesign("balance"), *gen2.Design, "should keep designs unknown to the library")
+	assert.Nil(t, gen2.SetUpAt, "should leave missing values as nil")
+	assert.Nil(t, gen2.Size, "should leave missing values as nil")
+}
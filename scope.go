@@ -0,0 +1,133 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// collectionScopes maps the v2 user collections to the scope granting access to them.
+var collectionScopes = map[string]Scope{
+	"personal_info":            ScopePersonal,
+	"daily_activity":           ScopeDaily,
+	"daily_sleep":              ScopeDaily,
+	"daily_readiness":          ScopeDaily,
+	"daily_stress":             ScopeDaily,
+	"daily_resilience":         ScopeDaily,
+	"daily_cardiovascular_age": ScopeDaily,
+	"vO2_max":                  ScopeDaily,
+	"sleep":                    ScopeDaily,
+	"sleep_time":               ScopeDaily,
+	"rest_mode_period":         ScopeDaily,
+	"ring_configuration":       ScopeDaily,
+	"daily_spo2":               ScopeSpO2,
+	"heartrate":                ScopeHeartRate,
+	"workout":                  ScopeWorkout,
+	"session":                  ScopeSession,
+	"tag":                      ScopeTag,
+	"enhanced_tag":             ScopeTag,
+}
+
+// RequiredScope returns the scope needed to read a v2 user collection, such as `heartrate`.
+func RequiredScope(collection string) (Scope, bool) {
+	scope, ok := collectionScopes[collection]
+	return scope, ok
+}
+
+// ScopeError is returned instead of an *APIError when a request for a v2 user collection is
+// rejected with a 403 status code, so the scope the token may be missing can be shown to the user.
+// A missing scope is only one possible cause, as Oura also returns a 403 status code when the
+// user's membership has lapsed. It wraps the *APIError, so errors.As and IsForbidden still work.
+//
+// Requests rejected with a 401 status code return a plain *APIError, as the token itself is
+// invalid, expired or revoked.
+type ScopeError struct {
+	// The collection that couldn't be read, eg `heartrate`
+	Collection string
+
+	// The scope needed to read the collection
+	Scope Scope
+
+	// The error returned by the API
+	Err *APIError
+}
+
+func (e *ScopeError) Error() string {
+	return fmt.Sprintf("%s (the token may be missing the %q scope needed to read %s)", e.Err, e.Scope, e.Collection)
+}
+
+// Unwrap returns the underlying API error.
+func (e *ScopeError) Unwrap() error {
+	return e.Err
+}
+
+// scopeError wraps an API error for a request to a v2 user collection in a ScopeError if the
+// request was forbidden. Any other error is returned unchanged.
+func scopeError(req *http.Request, err *APIError) error {
+	if err.StatusCode != http.StatusForbidden {
+		return err
+	}
+
+	collection := collectionOf(req.URL.Path)
+	scope, ok := collectionScopes[collection]
+	if !ok {
+		return err
+	}
+	return &ScopeError{Collection: collection, Scope: scope, Err: err}
+}
+
+// collectionOf returns the name of the v2 user collection a request path is for, or an empty
+// string if the path isn't for a user collection.
+func collectionOf(path string) string {
+	const marker = "/usercollection/"
+	i := strings.Index(path, marker)
+	if i < 0 {
+		return ""
+	}
+	collection, _, _ := strings.Cut(path[i+len(marker):], "/")
+	return collection
+}
+
+// Capabilities probes which v2 user collections the client's token can read. It makes a request
+// for each collection and returns a map from each collection, such as `heartrate`, to whether it
+// could be read. Collections rejected with a 403 status code are unavailable, and RequiredScope
+// returns the scope they need.
+//
+// If a probe is rejected with a 401 status code the token itself isn't valid, so probing stops
+// and the error is returned with a nil map. Collections that couldn't be probed for any other
+// reason are left out of the map, and their errors are joined in the returned error.
+func (c *Client) Capabilities(ctx context.Context) (map[string]bool, error) {
+	collections := make([]string, 0, len(collectionScopes))
+	for collection := range collectionScopes {
+		collections = append(collections, collection)
+	}
+	sort.Strings(collections)
+
+	caps := make(map[string]bool, len(collections))
+	var errs []error
+	for _, collection := range collections {
+		req, err := c.NewRequest(ctx, "GET", userCollectionPath+collection, nil)
+		if err != nil {
+			return caps, err
+		}
+
+		_, err = c.do(req, nil)
+		switch {
+		case err == nil:
+			caps[collection] = true
+		case IsUnauthorized(err):
+			return nil, fmt.Errorf("probing %s: %w", collection, err)
+		case IsForbidden(err):
+			caps[collection] = false
+		default:
+			if ctx.Err() != nil {
+				return caps, ctx.Err()
+			}
+			errs = append(errs, fmt.Errorf("probing %s: %w", collection, err))
+		}
+	}
+	return caps, errors.Join(errs...)
+}
@@ -0,0 +1,113 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopeError(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/heartrate", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"detail": "Missing scope"}`)
+	})
+	mux.HandleFunc("/v2/usercollection/sleep", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"detail": "Invalid access token"}`)
+	})
+	mux.HandleFunc("/v2/usercollection/daily_spo2/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/v2/usercollection/workout", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Heartrates(ctx, "", "", "")
+	assert.EqualError(t, err, `Forbidden: Missing scope (the token may be missing the "heartrate" scope needed to read heartrate)`)
+
+	var scopeErr *ScopeError
+	assert.True(t, errors.As(err, &scopeErr), "should return a *ScopeError")
+	assert.Equal(t, "heartrate", scopeErr.Collection)
+	assert.Equal(t, ScopeHeartRate, scopeErr.Scope)
+	assert.True(t, IsForbidden(err), "should still match the sentinel")
+	assert.True(t, errors.As(err, new(*APIError)), "should wrap the API error")
+
+	_, _, err = client.GetDailySpO2(ctx, "abc")
+	assert.True(t, errors.As(err, &scopeErr), "should return a *ScopeError for single documents")
+	assert.Equal(t, ScopeSpO2, scopeErr.Scope)
+	assert.True(t, IsForbidden(err))
+
+	_, _, err = client.Sleeps(ctx, "", "", "")
+	assert.False(t, errors.As(err, &scopeErr), "should not blame a scope for an invalid token")
+	assert.True(t, IsUnauthorized(err))
+
+	_, _, err = client.Workouts(ctx, "", "", "")
+	assert.False(t, errors.As(err, &scopeErr), "should not return a *ScopeError for other status codes")
+}
+
+func TestCollectionOf(t *testing.T) {
+	assert.Equal(t, "heartrate", collectionOf("/v2/usercollection/heartrate"))
+	assert.Equal(t, "workout", collectionOf("/v2/sandbox/usercollection/workout/abc"))
+	assert.Equal(t, "daily_sleep", collectionOf("/proxy/v2/usercollection/daily_sleep"))
+	assert.Equal(t, "", collectionOf("/v2/webhook/subscription"))
+	assert.Equal(t, "", collectionOf("/v1/userinfo"))
+}
+
+func TestCapabilities(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/", func(w http.ResponseWriter, r *http.Request) {
+		switch strings.TrimPrefix(r.URL.Path, "/v2/usercollection/") {
+		case "heartrate", "workout", "session":
+			w.WriteHeader(http.StatusForbidden)
+		case "tag":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			fmt.Fprint(w, `{"data": []}`)
+		}
+	})
+
+	caps, err := client.Capabilities(context.Background())
+	assert.EqualError(t, err, "probing tag: Bad Gateway")
+	assert.Len(t, caps, len(collectionScopes)-1, "should leave out collections that couldn't be probed")
+	assert.False(t, caps["heartrate"])
+	assert.False(t, caps["workout"])
+	assert.False(t, caps["session"])
+	assert.True(t, caps["daily_sleep"])
+	assert.True(t, caps["personal_info"])
+	assert.NotContains(t, caps, "tag")
+
+	t.Run("returns an error for an invalid token", func(t *testing.T) {
+		client, mux, teardown := setup()
+		defer teardown()
+
+		var probes int
+		mux.HandleFunc("/v2/usercollection/", func(w http.ResponseWriter, r *http.Request) {
+			probes++
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"detail": "Invalid access token"}`)
+		})
+
+		caps, err := client.Capabilities(context.Background())
+		assert.EqualError(t, err, "probing daily_activity: Unauthorized: Invalid access token")
+		assert.True(t, IsUnauthorized(err))
+		assert.Nil(t, caps)
+		assert.Equal(t, 1, probes, "should stop probing")
+	})
+
+	scope, ok := RequiredScope("workout")
+	assert.True(t, ok)
+	assert.Equal(t, ScopeWorkout, scope)
+	_, ok = RequiredScope("nope")
+	assert.False(t, ok)
+}
@@ -2,6 +2,7 @@ package oura
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -20,6 +21,9 @@ type Session struct {
 	// Timeseries data represented by an array of numbers; this data is available for sessions longer than 3 minutes
 	HeartRateVariability *timeSeriesData `json:"heart_rate_variability,omitempty"`
 
+	// Unique identifier of the document
+	ID string `json:"id"`
+
 	// The user's selected mood after the session:
 	// * `bad`
 	// * `worse`
@@ -51,6 +55,13 @@ type Sessions struct {
 	NextToken *string `json:"next_token,omitempty"`
 }
 
+func (d *Sessions) page() ([]Session, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
 // Sessions gets the session data within a given timeframe.
 // If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
 //
@@ -71,3 +82,48 @@ func (c *Client) Sessions(ctx context.Context, startDate, endDate, nextToken str
 
 	return data, resp, nil
 }
+
+// SessionsAll gets the session data within a given timeframe, following the pagination tokens until
+// all of the pages have been fetched. If a page fails to be fetched, the data fetched so far is
+// returned along with the error.
+func (c *Client) SessionsAll(ctx context.Context, startDate, endDate string) ([]Session, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.Sessions, startDate, endDate))
+}
+
+// SessionsSeq returns an iterator over the session data within a given timeframe. Each page is
+// fetched when the iteration reaches it, and fetching stops when the iteration does. If a page
+// fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) SessionsSeq(ctx context.Context, startDate, endDate string) iter.Seq2[Session, error] {
+	return Seq(ctx, c.maxPages, Pages(c.Sessions, startDate, endDate))
+}
+
+// SessionsWithOptions gets the session data using typed, validated parameters. An error wrapping
+// ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) SessionsWithOptions(ctx context.Context, opts *ListOptions) (*Sessions, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.Sessions(ctx, start, end, next)
+}
+
+// GetSession gets a single session by its ID. An error matching ErrNotFound is returned if there is
+// no session with the ID.
+func (c *Client) GetSession(ctx context.Context, id string) (*Session, *http.Response, error) {
+	path, err := documentPath("session", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *Session
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
@@ -3,6 +3,7 @@ package oura
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
@@ -67,6 +68,7 @@ type SleepPeriod struct {
 	Efficiency          *int              `json:"efficiency,omitempty"`
 	HeartRate           *timeSeriesData   `json:"heart_rate,omitempty"`
 	Hrv                 *timeSeriesData   `json:"hrv,omitempty"`
+	ID                  string            `json:"id"`
 	Latency             *int              `json:"latency,omitempty"`
 	LightSleepDuration  *int              `json:"light_sleep_duration,omitempty"`
 	LowBatteryAlert     bool              `json:"low_battery_alert"`
@@ -91,6 +93,13 @@ type SleepPeriods struct {
 	NextToken *string `json:"next_token,omitempty"`
 }
 
+func (d *SleepPeriods) page() ([]SleepPeriod, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
 type ReadinessSummary struct {
 	Contributors              ReadinessContributors `json:"contributors"`
 	Score                     *int                  `json:"score,omitempty"`
@@ -151,3 +160,48 @@ func (c *Client) Sleeps(ctx context.Context, startDate, endDate, nextToken strin
 
 	return data, resp, nil
 }
+
+// SleepsAll gets the detailed sleep data within a given timeframe, following the pagination tokens
+// until all of the pages have been fetched. If a page fails to be fetched, the data fetched so far
+// is returned along with the error.
+func (c *Client) SleepsAll(ctx context.Context, startDate, endDate string) ([]SleepPeriod, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.Sleeps, startDate, endDate))
+}
+
+// SleepsSeq returns an iterator over the detailed sleep data within a given timeframe. Each page is
+// fetched when the iteration reaches it, and fetching stops when the iteration does. If a page
+// fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) SleepsSeq(ctx context.Context, startDate, endDate string) iter.Seq2[SleepPeriod, error] {
+	return Seq(ctx, c.maxPages, Pages(c.Sleeps, startDate, endDate))
+}
+
+// SleepsWithOptions gets the detailed sleep data using typed, validated parameters. An error
+// wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) SleepsWithOptions(ctx context.Context, opts *ListOptions) (*SleepPeriods, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.Sleeps(ctx, start, end, next)
+}
+
+// GetSleepPeriod gets a single sleep period by its ID. An error matching ErrNotFound is returned if
+// there is no sleep period with the ID.
+func (c *Client) GetSleepPeriod(ctx context.Context, id string) (*SleepPeriod, *http.Response, error) {
+	path, err := documentPath("sleep", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *SleepPeriod
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
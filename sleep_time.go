@@ -0,0 +1,215 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// SleepTimeRecommendation is the recommended action for the user's bedtime.
+type SleepTimeRecommendation string
+
+// The supported bedtime recommendations.
+const (
+	SleepTimeImproveEfficiency    SleepTimeRecommendation = "improve_efficiency"
+	SleepTimeEarlierBedtime       SleepTimeRecommendation = "earlier_bedtime"
+	SleepTimeLaterBedtime         SleepTimeRecommendation = "later_bedtime"
+	SleepTimeEarliestBedtime      SleepTimeRecommendation = "earliest_bedtime"
+	SleepTimeLatestBedtime        SleepTimeRecommendation = "latest_bedtime"
+	SleepTimeFollowOptimalBedtime SleepTimeRecommendation = "follow_optimal_bedtime"
+)
+
+// SleepTimeStatus is the status of the bedtime recommendation.
+type SleepTimeStatus string
+
+// The supported bedtime recommendation statuses.
+const (
+	SleepTimeNotEnoughNights       SleepTimeStatus = "not_enough_nights"
+	SleepTimeNotEnoughRecentNights SleepTimeStatus = "not_enough_recent_nights"
+	SleepTimeBadSleepQuality       SleepTimeStatus = "bad_sleep_quality"
+	SleepTimeOnlyRecommendedFound  SleepTimeStatus = "only_recommended_found"
+	SleepTimeOptimalFound          SleepTimeStatus = "optimal_found"
+)
+
+// SleepTime represents the bedtime recommendation for a single day.
+type SleepTime struct {
+	// Unique identifier of the document
+	ID string `json:"id"`
+
+	// The `YYYY-MM-DD` formatted local date the recommendation is for
+	Day string `json:"day"`
+
+	// The optimal bedtime window, if one has been found
+	OptimalBedtime *SleepTimeWindow `json:"optimal_bedtime,omitempty"`
+
+	// The recommended action for the user's bedtime
+	Recommendation *SleepTimeRecommendation `json:"recommendation,omitempty"`
+
+	// The status of the recommendation
+	Status *SleepTimeStatus `json:"status,omitempty"`
+}
+
+// SleepTimeWindow represents a bedtime window as offsets from midnight at the start of the day.
+type SleepTimeWindow struct {
+	// The user's timezone offset from UTC (in seconds) on the day
+	DayTz int `json:"day_tz"`
+
+	// The end of the window (in seconds) relative to midnight. Negative values are before midnight.
+	EndOffset int `json:"end_offset"`
+
+	// The start of the window (in seconds) relative to midnight. Negative values are before midnight.
+	StartOffset int `json:"start_offset"`
+}
+
+// SleepTimes represents the bedtime recommendations within a given timeframe.
+type SleepTimes struct {
+	Data []SleepTime `json:"data"`
+	// Pagination token
+	NextToken *string `json:"next_token,omitempty"`
+}
+
+func (d *SleepTimes) page() ([]SleepTime, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
+// SleepTimes gets the bedtime recommendations within a given timeframe.
+// If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
+//
+//	startDate: endDate - 1 day
+//	endDate: current UTC date
+func (c *Client) SleepTimes(ctx context.Context, startDate, endDate, nextToken string) (*SleepTimes, *http.Response, error) {
+	path := parametiseDate("v2/usercollection/sleep_time", startDate, endDate, nextToken)
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *SleepTimes
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// SleepTimesAll gets the bedtime recommendations within a given timeframe, following the pagination
+// tokens until all of the pages have been fetched. If a page fails to be fetched, the data fetched
+// so far is returned along with the error.
+func (c *Client) SleepTimesAll(ctx context.Context, startDate, endDate string) ([]SleepTime, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.SleepTimes, startDate, endDate))
+}
+
+// SleepTimesSeq returns an iterator over the bedtime recommendations within a given timeframe. Each
+// page is fetched when the iteration reaches it, and fetching stops when the iteration does. If a
+// page fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) SleepTimesSeq(ctx context.Context, startDate, endDate string) iter.Seq2[SleepTime, error] {
+	return Seq(ctx, c.maxPages, Pages(c.SleepTimes, startDate, endDate))
+}
+
+// SleepTimesWithOptions gets the bedtime recommendations using typed, validated parameters. An
+// error wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) SleepTimesWithOptions(ctx context.Context, opts *ListOptions) (*SleepTimes, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.SleepTimes(ctx, start, end, next)
+}
+
+// GetSleepTime gets a single sleep time recommendation by its ID. An error matching ErrNotFound is
+// returned if there is no sleep time recommendation with the ID.
+func (c *Client) GetSleepTime(ctx context.Context, id string) (*SleepTime, *http.Response, error) {
+	path, err := documentPath("sleep_time", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *SleepTime
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// ErrNoBedtimeWindow is returned by SleepTime.BedtimeWindow when no optimal bedtime has been found.
+var ErrNoBedtimeWindow = errors.New("oura: no optimal bedtime window")
+
+// BedtimeWindow returns the start and end of the optimal bedtime window as times in the user's
+// timezone on the day.
+func (s SleepTime) BedtimeWindow() (start, end time.Time, err error) {
+	if s.OptimalBedtime == nil {
+		return time.Time{}, time.Time{}, ErrNoBedtimeWindow
+	}
+
+	day, err := ParseDate(s.Day)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	w := s.OptimalBedtime
+	midnight := day.In(time.FixedZone(tzName(w.DayTz), w.DayTz))
+	return midnight.Add(time.Duration(w.StartOffset) * time.Second), midnight.Add(time.Duration(w.EndOffset) * time.Second), nil
+}
+
+// tzName returns a name for a fixed timezone offset, such as `+01:00`.
+func tzName(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset%3600/60)
+}
+
+// v1BedtimeStatuses maps the statuses of v1 bedtimes to the v2 statuses.
+var v1BedtimeStatuses = map[string]SleepTimeStatus{
+	"IDEAL_BEDTIME_AVAILABLE": SleepTimeOptimalFound,
+	"LOW_SLEEP_SCORES":        SleepTimeBadSleepQuality,
+	"NEED_MORE_DATA":          SleepTimeNotEnoughNights,
+}
+
+// SleepTimes converts v1 ideal bedtimes into the v2 bedtime recommendations, so that they can be
+// handled the same way. The v1 API doesn't return the user's timezone, so it must be given as loc;
+// UTC is used if it's nil. Bedtimes with an unknown status have no status.
+func (b *IdealBedtimes) SleepTimes(loc *time.Location) []SleepTime {
+	if b == nil {
+		return nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	times := make([]SleepTime, 0, len(b.IdealBedtimes))
+	for _, bt := range b.IdealBedtimes {
+		st := SleepTime{Day: bt.Date}
+
+		if status, ok := v1BedtimeStatuses[bt.Status]; ok {
+			st.Status = &status
+			if status == SleepTimeOptimalFound {
+				var offset int
+				if day, err := ParseDate(bt.Date); err == nil {
+					_, offset = day.In(loc).Zone()
+				}
+				st.OptimalBedtime = &SleepTimeWindow{
+					DayTz:       offset,
+					StartOffset: bt.BedtimeWindow.Start,
+					EndOffset:   bt.BedtimeWindow.End,
+				}
+			}
+		}
+		times = append(times, st)
+	}
+	return times
+}
@@ -0,0 +1,155 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var sleepTimeCases = []struct {
+	name        string
+	startDate   string
+	endDate     string
+	nextToken   string
+	expectedURL string
+	mock        string
+}{
+	{
+		name:        "get sleep times without specific dates",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/sleep_time",
+		mock:        `testdata/v2/sleep_time.json`,
+	},
+	{
+		name:        "get sleep times with only start date",
+		startDate:   "2020-01-20",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/sleep_time?start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get sleep times with start and end dates",
+		startDate:   "2020-01-20",
+		endDate:     "2020-01-22",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/sleep_time?end_date=2020-01-22&start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get sleep times with next token",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "thisisbase64encodedjson",
+		expectedURL: "/v2/usercollection/sleep_time?next_token=thisisbase64encodedjson",
+		mock:        `{}`, // we don't care about the response here
+	},
+}
+
+func TestSleepTimes(t *testing.T) {
+	for _, tc := range sleepTimeCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := tc.mock
+			if strings.HasPrefix(tc.mock, "testdata/") {
+				resp, _ := os.ReadFile(tc.mock)
+				mock = string(resp)
+			}
+			testSleepTimes(t, tc.startDate, tc.endDate, tc.nextToken, tc.expectedURL, mock)
+		})
+	}
+}
+
+func testSleepTimes(t *testing.T, startDate, endDate, nextToken, expectedURL, mock string) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/sleep_time", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, expectedURL, r.URL.String())
+		fmt.Fprint(w, mock)
+	})
+
+	got, _, err := client.SleepTimes(context.Background(), startDate, endDate, nextToken)
+	assert.NoError(t, err, "should not return an error")
+
+	want := &SleepTimes{}
+	json.Unmarshal([]byte(mock), want)
+
+	assert.Equal(t, want, got)
+}
+
+func TestSleepTimesDecoding(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/sleep_time", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := os.ReadFile("testdata/v2/sleep_time.json")
+		w.Write(resp)
+	})
+
+	got, _, err := client.SleepTimes(context.Background(), "", "", "")
+	assert.NoError(t, err, "should not return an error")
+	assert.Len(t, got.Data, 2)
+
+	optimal := got.Data[0]
+	assert.Equal(t, &SleepTimeWindow{DayTz: 7200, EndOffset: 1800, StartOffset: -3600}, optimal.OptimalBedtime)
+	assert.Equal(t, SleepTimeFollowOptimalBedtime, *optimal.Recommendation)
+	assert.Equal(t, SleepTimeOptimalFound, *optimal.Status)
+
+	start, end, err := optimal.BedtimeWindow()
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, "2023-07-13T23:00:00+02:00", start.Format(time.RFC3339))
+	assert.Equal(t, "2023-07-14T00:30:00+02:00", end.Format(time.RFC3339))
+
+	missing := got.Data[1]
+	assert.Nil(t, missing.Recommendation, "should leave missing values as nil")
+	assert.Equal(t, SleepTimeNotEnoughNights, *missing.Status)
+	_, _, err = missing.BedtimeWindow()
+	assert.ErrorIs(t, err, ErrNoBedtimeWindow)
+}
+
+func TestIdealBedtimesSleepTimes(t *testing.T) {
+	bedtimes := &IdealBedtimes{}
+	json.Unmarshal([]byte(`{
+		"ideal_bedtimes": [
+			{"date": "2020-03-17", "bedtime_window": {"start": -3600, "end": 0}, "status": "IDEAL_BEDTIME_AVAILABLE"},
+			{"date": "2020-03-18", "bedtime_window": {"start": null, "end": null}, "status": "LOW_SLEEP_SCORES"},
+			{"date": "2020-03-19", "bedtime_window": {"start": null, "end": null}, "status": "SOMETHING_NEW"}
+		]
+	}`), bedtimes)
+
+	loc, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("timezone data is not available")
+	}
+
+	got := bedtimes.SleepTimes(loc)
+	assert.Len(t, got, 3)
+
+	assert.Equal(t, "2020-03-17", got[0].Day)
+	assert.Equal(t, SleepTimeOptimalFound, *got[0].Status)
+	start, end, err := got[0].BedtimeWindow()
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, "2020-03-16T23:00:00Z", start.Format(time.RFC3339))
+	assert.Equal(t, "2020-03-17T00:00:00Z", end.Format(time.RFC3339))
+
+	assert.Equal(t, SleepTimeBadSleepQuality, *got[1].Status)
+	assert.Nil(t, got[1].OptimalBedtime, "should not have a window without an ideal bedtime")
+	assert.Nil(t, got[2].Status, "should not guess unknown statuses")
+
+	// London is an hour ahead of UTC in the summer.
+	summer := (&IdealBedtimes{IdealBedtimes: []Bedtime{{Date: "2020-07-01", Status: "IDEAL_BEDTIME_AVAILABLE"}}}).SleepTimes(loc)
+	assert.Equal(t, 3600, summer[0].OptimalBedtime.DayTz)
+
+	var nilBedtimes *IdealBedtimes
+	assert.Nil(t, nilBedtimes.SleepTimes(nil))
+}
@@ -2,6 +2,7 @@ package oura
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -11,6 +12,9 @@ type Tag struct {
 	// The `YYYY-MM-DD` formatted local date indicating when the tag was collected
 	Day string `json:"day"`
 
+	// Unique identifier of the document
+	ID string `json:"id"`
+
 	// A list of tags selected by the user. A translation of tag values can be found [here](https://cloud.ouraring.com/edu/tag-translations).
 	Tags []string `json:"tags"`
 
@@ -28,11 +32,21 @@ type Tags struct {
 	NextToken *string `json:"next_token,omitempty"`
 }
 
+func (d *Tags) page() ([]Tag, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
 // Tags gets the tag data within a given timeframe.
 // If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
 //
 //	startDate: endDate - 1 day
 //	endDate: current UTC date
+//
+// Deprecated: Oura has replaced the tag collection with enhanced tags. Use EnhancedTags instead, and
+// Tag.EnhancedTags to convert older tags.
 func (c *Client) Tags(ctx context.Context, startDate, endDate, nextToken string) (*Tags, *http.Response, error) {
 	path := parametiseDate("v2/usercollection/tag", startDate, endDate, nextToken)
 	req, err := c.NewRequest(ctx, "GET", path, nil)
@@ -48,3 +62,50 @@ func (c *Client) Tags(ctx context.Context, startDate, endDate, nextToken string)
 
 	return data, resp, nil
 }
+
+// TagsAll gets the tag data within a given timeframe, following the pagination tokens until all of
+// the pages have been fetched. If a page fails to be fetched, the data fetched so far is returned
+// along with the error.
+func (c *Client) TagsAll(ctx context.Context, startDate, endDate string) ([]Tag, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.Tags, startDate, endDate))
+}
+
+// TagsSeq returns an iterator over the tag data within a given timeframe. Each page is fetched when
+// the iteration reaches it, and fetching stops when the iteration does. If a page fails to be
+// fetched, the error is yielded and the iteration ends.
+func (c *Client) TagsSeq(ctx context.Context, startDate, endDate string) iter.Seq2[Tag, error] {
+	return Seq(ctx, c.maxPages, Pages(c.Tags, startDate, endDate))
+}
+
+// TagsWithOptions gets the tag data using typed, validated parameters. An error wrapping
+// ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) TagsWithOptions(ctx context.Context, opts *ListOptions) (*Tags, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.Tags(ctx, start, end, next)
+}
+
+// GetTag gets a single tag by its ID. An error matching ErrNotFound is returned if
+// there is no tag with the ID.
+//
+// Deprecated: Oura has replaced the tag collection with enhanced tags. Use GetEnhancedTag instead.
+func (c *Client) GetTag(ctx context.Context, id string) (*Tag, *http.Response, error) {
+	path, err := documentPath("tag", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *Tag
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
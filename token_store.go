@@ -0,0 +1,239 @@
+package oura
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/oauth2"
+)
+
+// TokenStore persists the OAuth2 tokens of users so they survive restarts. Oura's refresh tokens
+// can only be used once, so the token saved after each refresh must replace the previous one.
+type TokenStore interface {
+	// Load returns the token saved for the user, or nil if there isn't one.
+	Load(ctx context.Context, userID string) (*oauth2.Token, error)
+
+	// Save saves the token for the user, replacing any existing token.
+	Save(ctx context.Context, userID string, tok *oauth2.Token) error
+}
+
+// The parameters used to derive the key of an encrypted FileTokenStore from its passphrase.
+const (
+	pbkdf2Iterations = 600000
+	saltSize         = 16
+	saltFile         = "salt"
+)
+
+// FileTokenStore is a TokenStore saving each user's token as a file in a directory. The files are
+// only readable by the current user, and may also be encrypted with a passphrase.
+type FileTokenStore struct {
+	dir  string
+	aead cipher.AEAD // nil if the tokens aren't encrypted
+}
+
+// NewFileTokenStore returns a FileTokenStore saving tokens as JSON in dir, creating the directory
+// if it doesn't exist.
+func NewFileTokenStore(dir string) (*FileTokenStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileTokenStore{dir: dir}, nil
+}
+
+// NewEncryptedFileTokenStore returns a FileTokenStore saving tokens in dir encrypted with AES-GCM,
+// using a key derived from the passphrase with PBKDF2. The random salt used to derive the key is
+// saved in the directory when it's first used, so the same passphrase must be used from then on.
+func NewEncryptedFileTokenStore(dir, passphrase string) (*FileTokenStore, error) {
+	if passphrase == "" {
+		return nil, errors.New("oura: passphrase is required")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	salt, err := loadSalt(filepath.Join(dir, saltFile))
+	if err != nil {
+		return nil, err
+	}
+	key := pbkdf2.Key([]byte(passphrase), salt, pbkdf2Iterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &FileTokenStore{dir: dir, aead: aead}, nil
+}
+
+// loadSalt reads the salt saved in name, creating it if it doesn't exist.
+func loadSalt(name string) ([]byte, error) {
+	salt, err := os.ReadFile(name)
+	if err == nil {
+		if len(salt) != saltSize {
+			return nil, fmt.Errorf("oura: invalid salt %s", name)
+		}
+		return salt, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	salt = make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(name, salt, 0o600); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+func (s *FileTokenStore) path(userID string) string {
+	ext := ".json"
+	if s.aead != nil {
+		ext = ".enc"
+	}
+	return filepath.Join(s.dir, url.PathEscape(userID)+ext)
+}
+
+// Load returns the token saved for the user, or nil if there isn't one.
+func (s *FileTokenStore) Load(_ context.Context, userID string) (*oauth2.Token, error) {
+	if userID == "" {
+		return nil, errors.New("oura: user ID is required")
+	}
+
+	data, err := os.ReadFile(s.path(userID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if s.aead != nil {
+		if data, err = s.open(userID, data); err != nil {
+			return nil, fmt.Errorf("oura: decrypting token %s: %w", s.path(userID), err)
+		}
+	}
+
+	var tok oauth2.Token
+	if err := json.Unmarshal(data, &tok); err != nil {
+		return nil, fmt.Errorf("oura: invalid token %s: %w", s.path(userID), err)
+	}
+	return &tok, nil
+}
+
+// Save saves the token for the user, replacing any existing token.
+func (s *FileTokenStore) Save(_ context.Context, userID string, tok *oauth2.Token) error {
+	if userID == "" {
+		return errors.New("oura: user ID is required")
+	}
+	if tok == nil {
+		return errors.New("oura: token is required")
+	}
+
+	data, err := json.Marshal(tok)
+	if err != nil {
+		return err
+	}
+	if s.aead != nil {
+		if data, err = s.seal(userID, data); err != nil {
+			return err
+		}
+	}
+	return writeFileAtomic(s.path(userID), data, 0o600)
+}
+
+// seal encrypts a token, binding it to the user ID so it can't be swapped with another user's.
+func (s *FileTokenStore) seal(userID string, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return s.aead.Seal(nonce, nonce, plaintext, []byte(userID)), nil
+}
+
+// open decrypts a token sealed by seal.
+func (s *FileTokenStore) open(userID string, ciphertext []byte) ([]byte, error) {
+	n := s.aead.NonceSize()
+	if len(ciphertext) < n {
+		return nil, errors.New("ciphertext is too short")
+	}
+	plaintext, err := s.aead.Open(nil, ciphertext[:n], ciphertext[n:], []byte(userID))
+	if err != nil {
+		return nil, errors.New("wrong passphrase or corrupted file")
+	}
+	return plaintext, nil
+}
+
+// savingTokenSource saves the tokens returned by another TokenSource whenever they change.
+type savingTokenSource struct {
+	ctx    context.Context
+	store  TokenStore
+	userID string
+	src    oauth2.TokenSource
+
+	mu    sync.Mutex
+	saved *oauth2.Token
+}
+
+// SavingTokenSource returns a TokenSource returning the tokens of src, saving each new token in
+// store for the user. Oura's refresh tokens can only be used once, so this keeps the stored
+// refresh token valid when src refreshes the access token. saved is the token already in the
+// store, if any, and isn't saved again.
+//
+// If a new token can't be saved the error is returned instead of the token, and saving is tried
+// again on the next call.
+func SavingTokenSource(ctx context.Context, store TokenStore, userID string, saved *oauth2.Token, src oauth2.TokenSource) oauth2.TokenSource {
+	return &savingTokenSource{ctx: ctx, store: store, userID: userID, src: src, saved: saved}
+}
+
+// Token implements oauth2.TokenSource.
+func (s *savingTokenSource) Token() (*oauth2.Token, error) {
+	// The lock is held while src is called too, otherwise a caller that got the old token could
+	// save it over a refreshed token saved by another caller.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tok, err := s.src.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.saved != nil && tok.AccessToken == s.saved.AccessToken && tok.RefreshToken == s.saved.RefreshToken {
+		return tok, nil
+	}
+	if err := s.store.Save(s.ctx, s.userID, tok); err != nil {
+		return nil, fmt.Errorf("oura: saving token: %w", err)
+	}
+	s.saved = tok
+	return tok, nil
+}
+
+// StoredTokenSource returns a TokenSource for the user using the token saved in store, refreshing
+// it with cfg when it expires and saving the refreshed token back in store. It returns an error if
+// there is no token saved for the user.
+func StoredTokenSource(ctx context.Context, cfg *oauth2.Config, store TokenStore, userID string) (oauth2.TokenSource, error) {
+	tok, err := store.Load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil {
+		return nil, fmt.Errorf("oura: no token saved for user %q", userID)
+	}
+	return SavingTokenSource(ctx, store, userID, tok, cfg.TokenSource(ctx, tok)), nil
+}
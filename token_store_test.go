@@ -0,0 +1,208 @@
+package oura
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func TestFileTokenStore(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "tokens")
+	store, err := NewFileTokenStore(dir)
+	assert.NoError(t, err, "should create the directory")
+
+	tok, err := store.Load(ctx, "user/1")
+	assert.NoError(t, err, "should not return an error for a missing token")
+	assert.Nil(t, tok)
+
+	saved := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", Expiry: time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)}
+	assert.NoError(t, store.Save(ctx, "user/1", saved))
+
+	tok, err = store.Load(ctx, "user/1")
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, saved, tok)
+
+	files, _ := os.ReadDir(dir)
+	assert.Len(t, files, 1, "should not leave temporary files behind")
+	info, _ := files[0].Info()
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	assert.EqualError(t, store.Save(ctx, "", saved), "oura: user ID is required")
+	assert.EqualError(t, store.Save(ctx, "user/1", nil), "oura: token is required")
+}
+
+func TestEncryptedFileTokenStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewEncryptedFileTokenStore(dir, "correct horse battery staple")
+	assert.NoError(t, err, "should not return an error")
+
+	saved := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer"}
+	assert.NoError(t, store.Save(ctx, "1", saved))
+	assert.NoError(t, store.Save(ctx, "2", &oauth2.Token{AccessToken: "other"}))
+
+	data, _ := os.ReadFile(filepath.Join(dir, "1.enc"))
+	assert.False(t, bytes.Contains(data, []byte("refresh")), "should not save the token in plain text")
+
+	reopened, err := NewEncryptedFileTokenStore(dir, "correct horse battery staple")
+	assert.NoError(t, err, "should not return an error")
+	tok, err := reopened.Load(ctx, "1")
+	assert.NoError(t, err, "should decrypt with the same passphrase")
+	assert.Equal(t, saved, tok)
+
+	wrong, _ := NewEncryptedFileTokenStore(dir, "wrong")
+	_, err = wrong.Load(ctx, "1")
+	assert.ErrorContains(t, err, "wrong passphrase or corrupted file")
+
+	other, _ := os.ReadFile(filepath.Join(dir, "2.enc"))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "1.enc"), other, 0o600))
+	_, err = store.Load(ctx, "1")
+	assert.Error(t, err, "should not decrypt another user's token")
+
+	_, err = NewEncryptedFileTokenStore(dir, "")
+	assert.EqualError(t, err, "oura: passphrase is required")
+}
+
+// memoryTokenStore is a TokenStore recording the tokens saved in it.
+type memoryTokenStore struct {
+	tokens map[string]*oauth2.Token
+	saves  int
+	err    error
+}
+
+func (s *memoryTokenStore) Load(_ context.Context, userID string) (*oauth2.Token, error) {
+	return s.tokens[userID], nil
+}
+
+func (s *memoryTokenStore) Save(_ context.Context, userID string, tok *oauth2.Token) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.tokens == nil {
+		s.tokens = make(map[string]*oauth2.Token)
+	}
+	s.tokens[userID] = tok
+	s.saves++
+	return nil
+}
+
+// tokenSequence is a TokenSource returning the next token each time it's called.
+type tokenSequence []*oauth2.Token
+
+func (s *tokenSequence) Token() (*oauth2.Token, error) {
+	tok := (*s)[0]
+	if len(*s) > 1 {
+		*s = (*s)[1:]
+	}
+	return tok, nil
+}
+
+func TestSavingTokenSource(t *testing.T) {
+	first := &oauth2.Token{AccessToken: "a1", RefreshToken: "r1"}
+	second := &oauth2.Token{AccessToken: "a2", RefreshToken: "r2"}
+	src := tokenSequence{first, first, second, second}
+	store := &memoryTokenStore{}
+
+	ts := SavingTokenSource(context.Background(), store, "1", first, &src)
+
+	tok, err := ts.Token()
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, first, tok)
+	assert.Equal(t, 0, store.saves, "should not save the token it started with")
+
+	_, _ = ts.Token()
+	store.err = errors.New("disk full")
+	_, err = ts.Token()
+	assert.EqualError(t, err, "oura: saving token: disk full")
+
+	store.err = nil
+	tok, err = ts.Token()
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, second, tok)
+	assert.Equal(t, 1, store.saves, "should retry saving the refreshed token once")
+	assert.Equal(t, second, store.tokens["1"])
+}
+
+// racingTokenSource returns old to its first caller, waiting briefly for another caller to get new
+// before returning, as an oauth2 source refreshing for a second caller would.
+type racingTokenSource struct {
+	old, new *oauth2.Token
+	calls    atomic.Int32
+	refresh  chan struct{}
+}
+
+func (s *racingTokenSource) Token() (*oauth2.Token, error) {
+	if s.calls.Add(1) == 1 {
+		select {
+		case <-s.refresh:
+		case <-time.After(50 * time.Millisecond):
+		}
+		return s.old, nil
+	}
+	close(s.refresh)
+	return s.new, nil
+}
+
+func TestSavingTokenSourceConcurrent(t *testing.T) {
+	old := &oauth2.Token{AccessToken: "a1", RefreshToken: "r1"}
+	refreshed := &oauth2.Token{AccessToken: "a2", RefreshToken: "r2"}
+	src := &racingTokenSource{old: old, new: refreshed, refresh: make(chan struct{})}
+	store := &memoryTokenStore{}
+	ts := SavingTokenSource(context.Background(), store, "1", nil, src)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := ts.Token()
+			assert.NoError(t, err)
+		}()
+		time.Sleep(10 * time.Millisecond)
+	}
+	wg.Wait()
+
+	assert.Equal(t, refreshed, store.tokens["1"], "should not save the old token over the refreshed one")
+}
+
+func TestStoredTokenSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "r1", r.PostForm.Get("refresh_token"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "a2", "refresh_token": "r2", "token_type": "bearer", "expires_in": 3600}`)
+	}))
+	defer server.Close()
+
+	cfg := OAuthConfig("id", "secret", "http://localhost:8080/callback")
+	cfg.Endpoint.TokenURL = server.URL
+
+	ctx := context.Background()
+	store := &memoryTokenStore{tokens: map[string]*oauth2.Token{
+		"1": {AccessToken: "a1", RefreshToken: "r1", Expiry: time.Now().Add(-time.Hour)},
+	}}
+
+	ts, err := StoredTokenSource(ctx, cfg, store, "1")
+	assert.NoError(t, err, "should not return an error")
+	tok, err := ts.Token()
+	assert.NoError(t, err, "should refresh the expired token")
+	assert.Equal(t, "a2", tok.AccessToken)
+	assert.Equal(t, "r2", store.tokens["1"].RefreshToken, "should save the rotated refresh token")
+
+	_, err = StoredTokenSource(ctx, cfg, store, "2")
+	assert.EqualError(t, err, `oura: no token saved for user "2"`)
+}
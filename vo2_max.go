@@ -0,0 +1,103 @@
+package oura
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// VO2Max represents the data returned from the Oura API for a single VO2 max estimate.
+type VO2Max struct {
+	// Unique identifier of the document
+	ID string `json:"id"`
+
+	// The `YYYY-MM-DD` formatted local date indicating when the VO2 max was estimated
+	Day string `json:"day"`
+
+	// ISO 8601 formatted local timestamp indicating when the VO2 max was estimated
+	Timestamp time.Time `json:"timestamp"`
+
+	// The estimated maximum rate of oxygen consumption (in ml/kg/min)
+	VO2Max *float32 `json:"vo2_max,omitempty"`
+}
+
+// VO2Maxes represents the VO2 max estimates within a given timeframe.
+type VO2Maxes struct {
+	Data []VO2Max `json:"data"`
+	// Pagination token
+	NextToken *string `json:"next_token,omitempty"`
+}
+
+func (d *VO2Maxes) page() ([]VO2Max, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
+// VO2Maxes gets the VO2 max estimates within a given timeframe.
+// If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
+//
+//	startDate: endDate - 1 day
+//	endDate: current UTC date
+func (c *Client) VO2Maxes(ctx context.Context, startDate, endDate, nextToken string) (*VO2Maxes, *http.Response, error) {
+	path := parametiseDate("v2/usercollection/vO2_max", startDate, endDate, nextToken)
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *VO2Maxes
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// VO2MaxesAll gets the VO2 max estimates within a given timeframe, following the pagination tokens
+// until all of the pages have been fetched. If a page fails to be fetched, the data fetched so far
+// is returned along with the error.
+func (c *Client) VO2MaxesAll(ctx context.Context, startDate, endDate string) ([]VO2Max, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.VO2Maxes, startDate, endDate))
+}
+
+// VO2MaxesSeq returns an iterator over the VO2 max estimates within a given timeframe. Each page is
+// fetched when the iteration reaches it, and fetching stops when the iteration does. If a page
+// fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) VO2MaxesSeq(ctx context.Context, startDate, endDate string) iter.Seq2[VO2Max, error] {
+	return Seq(ctx, c.maxPages, Pages(c.VO2Maxes, startDate, endDate))
+}
+
+// VO2MaxesWithOptions gets the VO2 max estimates using typed, validated parameters. An error
+// wrapping ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) VO2MaxesWithOptions(ctx context.Context, opts *ListOptions) (*VO2Maxes, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.VO2Maxes(ctx, start, end, next)
+}
+
+// GetVO2Max gets a single VO2 max estimate by its ID. An error matching ErrNotFound is returned if
+// there is no VO2 max estimate with the ID.
+func (c *Client) GetVO2Max(ctx context.Context, id string) (*VO2Max, *http.Response, error) {
+	path, err := documentPath("vO2_max", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *VO2Max
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
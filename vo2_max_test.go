@@ -0,0 +1,129 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var vo2MaxCases = []struct {
+	name        string
+	startDate   string
+	endDate     string
+	nextToken   string
+	expectedURL string
+	mock        string
+}{
+	{
+		name:        "get VO2 max estimates without specific dates",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/vO2_max",
+		mock:        `testdata/v2/vO2_max.json`,
+	},
+	{
+		name:        "get VO2 max estimates with only start date",
+		startDate:   "2020-01-20",
+		endDate:     "",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/vO2_max?start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get VO2 max estimates with start and end dates",
+		startDate:   "2020-01-20",
+		endDate:     "2020-01-22",
+		nextToken:   "",
+		expectedURL: "/v2/usercollection/vO2_max?end_date=2020-01-22&start_date=2020-01-20",
+		mock:        `{}`, // we don't care about the response here
+	},
+	{
+		name:        "get VO2 max estimates with next token",
+		startDate:   "",
+		endDate:     "",
+		nextToken:   "thisisbase64encodedjson",
+		expectedURL: "/v2/usercollection/vO2_max?next_token=thisisbase64encodedjson",
+		mock:        `{}`, // we don't care about the response here
+	},
+}
+
+func TestVO2Maxes(t *testing.T) {
+	for _, tc := range vo2MaxCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := tc.mock
+			if strings.HasPrefix(tc.mock, "testdata/") {
+				resp, _ := os.ReadFile(tc.mock)
+				mock = string(resp)
+			}
+			testVO2Maxes(t, tc.startDate, tc.endDate, tc.nextToken, tc.expectedURL, mock)
+		})
+	}
+}
+
+func testVO2Maxes(t *testing.T, startDate, endDate, nextToken, expectedURL, mock string) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/vO2_max", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, expectedURL, r.URL.String())
+		fmt.Fprint(w, mock)
+	})
+
+	got, _, err := client.VO2Maxes(context.Background(), startDate, endDate, nextToken)
+	assert.NoError(t, err, "should not return an error")
+
+	want := &VO2Maxes{}
+	json.Unmarshal([]byte(mock), want)
+
+	assert.Equal(t, want, got)
+}
+
+func TestVO2MaxesDecoding(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/vO2_max", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := os.ReadFile("testdata/v2/vO2_max.json")
+		w.Write(resp)
+	})
+
+	got, _, err := client.VO2Maxes(context.Background(), "", "", "")
+	assert.NoError(t, err, "should not return an error")
+	assert.Len(t, got.Data, 2)
+	assert.Equal(t, "9a6c1e2f-4b7d-4e3a-8f5c-1d2b3a4c5e6f", got.Data[0].ID)
+	assert.Equal(t, time.Date(2024, 2, 10, 7, 30, 0, 0, time.UTC), got.Data[0].Timestamp.UTC())
+	assert.Equal(t, float32(42.5), *got.Data[0].VO2Max)
+	assert.Nil(t, got.Data[1].VO2Max, "should leave missing estimates as nil")
+}
+
+func TestGetVO2Max(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/vO2_max/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		if r.URL.Path != "/v2/usercollection/vO2_max/9a6c1e2f" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"detail": "Document not found."}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": "9a6c1e2f", "day": "2024-02-10", "timestamp": "2024-02-10T07:30:00+00:00", "vo2_max": 42.5}`)
+	})
+
+	got, _, err := client.GetVO2Max(context.Background(), "9a6c1e2f")
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, "2024-02-10", got.Day)
+	assert.Equal(t, float32(42.5), *got.VO2Max)
+
+	_, _, err = client.GetVO2Max(context.Background(), "missing")
+	assert.True(t, IsNotFound(err), "should return a not found error")
+}
@@ -0,0 +1,228 @@
+package oura
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// webhookSubscriptionPath is the path of the webhook subscription API.
+const webhookSubscriptionPath = "v2/webhook/subscription"
+
+// WebhookEventType is the kind of change a webhook subscription notifies about.
+type WebhookEventType string
+
+// The event types of webhook subscriptions.
+const (
+	WebhookEventCreate WebhookEventType = "create"
+	WebhookEventUpdate WebhookEventType = "update"
+	WebhookEventDelete WebhookEventType = "delete"
+)
+
+// WebhookDataType is the collection a webhook subscription notifies about.
+type WebhookDataType string
+
+// The data types of webhook subscriptions.
+const (
+	WebhookDataTag                    WebhookDataType = "tag"
+	WebhookDataEnhancedTag            WebhookDataType = "enhanced_tag"
+	WebhookDataWorkout                WebhookDataType = "workout"
+	WebhookDataSession                WebhookDataType = "session"
+	WebhookDataSleep                  WebhookDataType = "sleep"
+	WebhookDataDailySleep             WebhookDataType = "daily_sleep"
+	WebhookDataDailyReadiness         WebhookDataType = "daily_readiness"
+	WebhookDataDailyActivity          WebhookDataType = "daily_activity"
+	WebhookDataDailySpO2              WebhookDataType = "daily_spo2"
+	WebhookDataSleepTime              WebhookDataType = "sleep_time"
+	WebhookDataRestModePeriod         WebhookDataType = "rest_mode_period"
+	WebhookDataRingConfiguration      WebhookDataType = "ring_configuration"
+	WebhookDataDailyStress            WebhookDataType = "daily_stress"
+	WebhookDataDailyResilience        WebhookDataType = "daily_resilience"
+	WebhookDataDailyCardiovascularAge WebhookDataType = "daily_cardiovascular_age"
+	WebhookDataVO2Max                 WebhookDataType = "vo2_max"
+)
+
+// WebhookSubscription represents a webhook subscription of an Oura application.
+type WebhookSubscription struct {
+	// Unique identifier of the subscription
+	ID string `json:"id"`
+
+	// The URL notifications are sent to
+	CallbackURL string `json:"callback_url"`
+
+	// The kind of change notified about
+	EventType WebhookEventType `json:"event_type"`
+
+	// The collection notified about
+	DataType WebhookDataType `json:"data_type"`
+
+	// When the subscription expires unless it's renewed
+	ExpirationTime time.Time `json:"expiration_time"`
+}
+
+// WebhookSubscriptionRequest holds the parameters of a new webhook subscription.
+type WebhookSubscriptionRequest struct {
+	// The URL notifications are sent to
+	CallbackURL string `json:"callback_url"`
+
+	// The token Oura sends to the callback URL to verify the subscription
+	VerificationToken string `json:"verification_token"`
+
+	// The kind of change to notify about
+	EventType WebhookEventType `json:"event_type"`
+
+	// The collection to notify about
+	DataType WebhookDataType `json:"data_type"`
+}
+
+// WebhookSubscriptionUpdate holds the changes to a webhook subscription. Empty fields are left
+// unchanged, except for VerificationToken which is always required.
+type WebhookSubscriptionUpdate struct {
+	// The token Oura sends to the callback URL to verify the subscription
+	VerificationToken string `json:"verification_token"`
+
+	// The URL notifications are sent to
+	CallbackURL string `json:"callback_url,omitempty"`
+
+	// The kind of change to notify about
+	EventType WebhookEventType `json:"event_type,omitempty"`
+
+	// The collection to notify about
+	DataType WebhookDataType `json:"data_type,omitempty"`
+}
+
+// WebhookClient manages the webhook subscriptions of an Oura application. Unlike the Client, it
+// authenticates with the application's client ID and secret rather than a user's access token.
+type WebhookClient struct {
+	client       *Client
+	clientID     string
+	clientSecret string
+}
+
+// NewWebhookClient returns a new webhook subscription client for the application with the given
+// client ID and secret. If a nil httpClient is provided, http.DefaultClient will be used. The
+// options are the same as those of NewClient.
+func NewWebhookClient(cc *http.Client, clientID, clientSecret string, opts ...Option) (*WebhookClient, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, errors.New("oura: client ID and secret are required")
+	}
+
+	c, err := NewClient(cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookClient{client: c, clientID: clientID, clientSecret: clientSecret}, nil
+}
+
+// newRequest creates an API request authenticated with the application's credentials.
+func (w *WebhookClient) newRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+	req, err := w.client.NewRequest(ctx, method, urlStr, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-client-id", w.clientID)
+	req.Header.Set("x-client-secret", w.clientSecret)
+	return req, nil
+}
+
+// subscriptionPath returns the path of a single webhook subscription, optionally below a prefix
+// such as "renew".
+func subscriptionPath(prefix, id string) (string, error) {
+	if id == "" {
+		return "", errors.New("oura: subscription ID is required")
+	}
+	if prefix != "" {
+		prefix += "/"
+	}
+	return webhookSubscriptionPath + "/" + prefix + url.PathEscape(id), nil
+}
+
+// Subscriptions lists the webhook subscriptions of the application.
+func (w *WebhookClient) Subscriptions(ctx context.Context) ([]WebhookSubscription, *http.Response, error) {
+	req, err := w.newRequest(ctx, "GET", webhookSubscriptionPath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []WebhookSubscription
+	resp, err := w.client.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
+
+// GetSubscription gets a single webhook subscription by its ID. An error matching ErrNotFound is
+// returned if there is no subscription with the ID.
+func (w *WebhookClient) GetSubscription(ctx context.Context, id string) (*WebhookSubscription, *http.Response, error) {
+	path, err := subscriptionPath("", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return w.subscription(ctx, "GET", path, nil)
+}
+
+// CreateSubscription creates a webhook subscription. Oura verifies the subscription by sending
+// the verification token and a challenge to the callback URL before responding, so the callback
+// URL must be reachable and able to answer the challenge.
+func (w *WebhookClient) CreateSubscription(ctx context.Context, sub *WebhookSubscriptionRequest) (*WebhookSubscription, *http.Response, error) {
+	if sub == nil {
+		return nil, nil, errors.New("oura: subscription request is required")
+	}
+	return w.subscription(ctx, "POST", webhookSubscriptionPath, sub)
+}
+
+// UpdateSubscription updates the webhook subscription with the given ID.
+func (w *WebhookClient) UpdateSubscription(ctx context.Context, id string, update *WebhookSubscriptionUpdate) (*WebhookSubscription, *http.Response, error) {
+	if update == nil {
+		return nil, nil, errors.New("oura: subscription update is required")
+	}
+	path, err := subscriptionPath("", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return w.subscription(ctx, "PUT", path, update)
+}
+
+// RenewSubscription extends the expiration time of the webhook subscription with the given ID.
+// Subscriptions stop sending notifications once they expire, so they should be renewed before
+// their ExpirationTime.
+func (w *WebhookClient) RenewSubscription(ctx context.Context, id string) (*WebhookSubscription, *http.Response, error) {
+	path, err := subscriptionPath("renew", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return w.subscription(ctx, "PUT", path, nil)
+}
+
+// DeleteSubscription deletes the webhook subscription with the given ID.
+func (w *WebhookClient) DeleteSubscription(ctx context.Context, id string) (*http.Response, error) {
+	path, err := subscriptionPath("", id)
+	if err != nil {
+		return nil, err
+	}
+	req, err := w.newRequest(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return w.client.do(req, nil)
+}
+
+// subscription makes a request returning a single webhook subscription.
+func (w *WebhookClient) subscription(ctx context.Context, method, path string, body interface{}) (*WebhookSubscription, *http.Response, error) {
+	req, err := w.newRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *WebhookSubscription
+	resp, err := w.client.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}
@@ -0,0 +1,275 @@
+package oura
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxWebhookBody is the largest notification body the WebhookHandler accepts.
+const maxWebhookBody = 1 << 20
+
+// DefaultWebhookTolerance is how old a notification's timestamp may be before SignatureVerifier
+// rejects it.
+const DefaultWebhookTolerance = 5 * time.Minute
+
+// WebhookEvent is a notification sent by Oura to a webhook subscription's callback URL.
+type WebhookEvent struct {
+	// The kind of change
+	EventType WebhookEventType `json:"event_type"`
+
+	// The collection of the changed document
+	DataType WebhookDataType `json:"data_type"`
+
+	// The ID of the changed document
+	ObjectID string `json:"object_id"`
+
+	// The ID of the user the document belongs to
+	UserID string `json:"user_id"`
+
+	// When the change happened
+	EventTime time.Time `json:"event_time"`
+}
+
+// WebhookEventFunc handles a webhook notification. If the WebhookHandler fetches documents, doc
+// is the changed document, such as a *DailySleep for WebhookDataDailySleep events, otherwise it's
+// nil. It's always nil for delete events. Returning an error makes the handler respond with a
+// server error, so Oura sends the notification again later.
+type WebhookEventFunc func(ctx context.Context, event *WebhookEvent, doc interface{}) error
+
+// WebhookVerifier checks that a notification was sent by Oura, returning an error if it wasn't.
+// body is the notification's body, which has already been read from r.
+type WebhookVerifier func(r *http.Request, body []byte) error
+
+// WebhookHandler is an http.Handler receiving the notifications of webhook subscriptions. It
+// answers Oura's verification challenge when a subscription is created, and passes the
+// notifications it receives to the functions registered for their data types.
+type WebhookHandler struct {
+	verificationToken string
+	mu                sync.RWMutex
+	handlers          map[WebhookDataType]WebhookEventFunc
+
+	// Verify checks each notification before it's handled, and the notification is rejected if it
+	// returns an error. NewWebhookHandler sets it to check Oura's signature with SignatureVerifier.
+	// If it's nil, notifications aren't authenticated, so anyone who can reach the callback URL
+	// can send them, and make ClientFor fetch documents for any user.
+	Verify WebhookVerifier
+
+	// ClientFor returns the client used to fetch the changed document of a notification for the
+	// given user. Documents aren't fetched if it's nil.
+	ClientFor func(ctx context.Context, userID string) (*Client, error)
+
+	// Logger logs the notifications that couldn't be handled. Nothing is logged if it's nil.
+	Logger Logger
+}
+
+// NewWebhookHandler returns a webhook handler accepting the subscriptions created with the given
+// verification token, and the notifications signed with the application's client secret.
+func NewWebhookHandler(verificationToken, clientSecret string) *WebhookHandler {
+	return &WebhookHandler{
+		verificationToken: verificationToken,
+		handlers:          make(map[WebhookDataType]WebhookEventFunc),
+		Verify:            SignatureVerifier(clientSecret, DefaultWebhookTolerance),
+	}
+}
+
+// Handle registers the function handling the notifications for a data type, replacing any
+// function already registered for it. Notifications for data types without a function are
+// acknowledged and ignored. It may be called while the handler is serving requests, and panics if
+// fn is nil.
+func (h *WebhookHandler) Handle(dataType WebhookDataType, fn WebhookEventFunc) {
+	if fn == nil {
+		panic("oura: nil WebhookEventFunc")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[dataType] = fn
+}
+
+// ServeHTTP implements http.Handler.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.verify(w, r)
+	case http.MethodPost:
+		h.notify(w, r)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// verify answers the challenge Oura sends to a callback URL when a subscription is created.
+func (h *WebhookHandler) verify(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	token := q.Get("verification_token")
+	if h.verificationToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.verificationToken)) != 1 {
+		http.Error(w, "invalid verification token", http.StatusUnauthorized)
+		return
+	}
+
+	challenge := q.Get("challenge")
+	if challenge == "" {
+		http.Error(w, "missing challenge", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"challenge": challenge}) //nolint:errcheck // The response can't be changed once it's being written.
+}
+
+// notify handles a notification POSTed by Oura.
+func (h *WebhookHandler) notify(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "invalid notification", http.StatusBadRequest)
+		return
+	}
+	if h.Verify != nil {
+		if err := h.Verify(r, body); err != nil {
+			h.logf("rejecting notification: %v", err)
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "invalid notification", http.StatusBadRequest)
+		return
+	}
+	if event.DataType == "" || event.EventType == "" {
+		http.Error(w, "invalid notification", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), &event); err != nil {
+		h.logf("handling %s %s event for %s: %v", event.DataType, event.EventType, event.ObjectID, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// dispatch passes an event, and the changed document if it's fetched, to the function registered
+// for its data type.
+func (h *WebhookHandler) dispatch(ctx context.Context, event *WebhookEvent) error {
+	h.mu.RLock()
+	fn, ok := h.handlers[event.DataType]
+	h.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	var doc interface{}
+	if h.ClientFor != nil && event.EventType != WebhookEventDelete {
+		var err error
+		if doc, err = h.fetch(ctx, event); err != nil {
+			return err
+		}
+	}
+	return fn(ctx, event, doc)
+}
+
+// fetch gets the document changed by an event using the getter for its data type.
+func (h *WebhookHandler) fetch(ctx context.Context, event *WebhookEvent) (interface{}, error) {
+	get, ok := documentGetters[event.DataType]
+	if !ok {
+		return nil, nil
+	}
+
+	c, err := h.ClientFor(ctx, event.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("getting client for user %q: %w", event.UserID, err)
+	}
+	return get(ctx, c, event.ObjectID)
+}
+
+// SignatureVerifier returns a WebhookVerifier checking the signature Oura sends with each
+// notification: a hex encoded HMAC-SHA256 of the x-oura-timestamp header followed by the body,
+// keyed with the application's client secret, in the x-oura-signature header. Notifications whose
+// Unix timestamp is more than tolerance from the current time are rejected, so that captured
+// notifications can't be replayed later.
+func SignatureVerifier(clientSecret string, tolerance time.Duration) WebhookVerifier {
+	return func(r *http.Request, body []byte) error {
+		return verifySignature(clientSecret, tolerance, time.Now(), r.Header, body)
+	}
+}
+
+func verifySignature(clientSecret string, tolerance time.Duration, now time.Time, header http.Header, body []byte) error {
+	if clientSecret == "" {
+		return errors.New("no client secret to verify the signature with")
+	}
+
+	timestamp := header.Get("x-oura-timestamp")
+	secs, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %q", timestamp)
+	}
+	if age := now.Sub(time.Unix(secs, 0)); age > tolerance || age < -tolerance {
+		return fmt.Errorf("timestamp %s is outside the tolerance of %s", timestamp, tolerance)
+	}
+
+	sig, err := hex.DecodeString(header.Get("x-oura-signature"))
+	if err != nil || len(sig) == 0 {
+		return errors.New("missing or invalid signature")
+	}
+	mac := hmac.New(sha256.New, []byte(clientSecret))
+	mac.Write([]byte(timestamp))
+	mac.Write(body)
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func (h *WebhookHandler) logf(format string, v ...interface{}) {
+	if h.Logger != nil {
+		h.Logger.Printf("oura: "+format, v...)
+	}
+}
+
+// documentGetter fetches a single document by its ID.
+type documentGetter func(ctx context.Context, c *Client, id string) (interface{}, error)
+
+// getter adapts a single-document method to a documentGetter.
+func getter[T any](get func(*Client, context.Context, string) (*T, *http.Response, error)) documentGetter {
+	return func(ctx context.Context, c *Client, id string) (interface{}, error) {
+		doc, _, err := get(c, ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return doc, nil
+	}
+}
+
+// documentGetters maps the webhook data types to the methods fetching their documents.
+var documentGetters = map[WebhookDataType]documentGetter{
+	WebhookDataTag:                    getter((*Client).GetTag), //nolint:staticcheck // Oura still notifies about the deprecated collection.
+	WebhookDataEnhancedTag:            getter((*Client).GetEnhancedTag),
+	WebhookDataWorkout:                getter((*Client).GetWorkout),
+	WebhookDataSession:                getter((*Client).GetSession),
+	WebhookDataSleep:                  getter((*Client).GetSleepPeriod),
+	WebhookDataDailySleep:             getter((*Client).GetDailySleep),
+	WebhookDataDailyReadiness:         getter((*Client).GetDailyReadiness),
+	WebhookDataDailyActivity:          getter((*Client).GetDailyActivity),
+	WebhookDataDailySpO2:              getter((*Client).GetDailySpO2),
+	WebhookDataSleepTime:              getter((*Client).GetSleepTime),
+	WebhookDataRestModePeriod:         getter((*Client).GetRestModePeriod),
+	WebhookDataRingConfiguration:      getter((*Client).GetRingConfiguration),
+	WebhookDataDailyStress:            getter((*Client).GetDailyStress),
+	WebhookDataDailyResilience:        getter((*Client).GetDailyResilience),
+	WebhookDataDailyCardiovascularAge: getter((*Client).GetDailyCardiovascularAge),
+	WebhookDataVO2Max:                 getter((*Client).GetVO2Max),
+}
@@ -0,0 +1,234 @@
+package oura
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// signedRequest returns a notification request signed with the client secret, as Oura sends it.
+func signedRequest(clientSecret, body string) *http.Request {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(clientSecret))
+	mac.Write([]byte(timestamp + body))
+
+	req := httptest.NewRequest(http.MethodPost, "/oura", strings.NewReader(body))
+	req.Header.Set("x-oura-timestamp", timestamp)
+	req.Header.Set("x-oura-signature", strings.ToUpper(hex.EncodeToString(mac.Sum(nil))))
+	return req
+}
+
+func TestWebhookHandlerVerify(t *testing.T) {
+	h := NewWebhookHandler("s3cret", "client-secret")
+
+	cases := []struct {
+		name   string
+		query  string
+		status int
+		body   string
+	}{
+		{"valid token", "verification_token=s3cret&challenge=abc123", http.StatusOK, `{"challenge":"abc123"}` + "\n"},
+		{"wrong token", "verification_token=nope&challenge=abc123", http.StatusUnauthorized, "invalid verification token\n"},
+		{"missing token", "challenge=abc123", http.StatusUnauthorized, "invalid verification token\n"},
+		{"missing challenge", "verification_token=s3cret", http.StatusBadRequest, "missing challenge\n"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/oura?"+tc.query, nil))
+			assert.Equal(t, tc.status, rec.Code)
+			assert.Equal(t, tc.body, rec.Body.String())
+		})
+	}
+}
+
+func TestWebhookHandlerNotify(t *testing.T) {
+	h := NewWebhookHandler("s3cret", "client-secret")
+
+	var got []*WebhookEvent
+	h.Handle(WebhookDataDailySleep, func(ctx context.Context, event *WebhookEvent, doc interface{}) error {
+		assert.Nil(t, doc, "should not fetch documents without ClientFor")
+		got = append(got, event)
+		return nil
+	})
+	h.Handle(WebhookDataWorkout, func(ctx context.Context, event *WebhookEvent, doc interface{}) error {
+		return errors.New("database down")
+	})
+
+	post := func(body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest("client-secret", body))
+		return rec
+	}
+
+	rec := post(`{"event_type": "update", "data_type": "daily_sleep", "object_id": "doc-1", "user_id": "user-1", "event_time": "2024-03-20T12:00:00Z"}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, []*WebhookEvent{{
+		EventType: WebhookEventUpdate,
+		DataType:  WebhookDataDailySleep,
+		ObjectID:  "doc-1",
+		UserID:    "user-1",
+		EventTime: time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC),
+	}}, got)
+
+	rec = post(`{"event_type": "create", "data_type": "session", "object_id": "doc-2", "user_id": "user-1"}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code, "should acknowledge data types without a handler")
+
+	rec = post(`{"event_type": "create", "data_type": "workout", "object_id": "doc-3", "user_id": "user-1"}`)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code, "should fail when the handler fails")
+
+	rec = post(`not json`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = post(`{"object_id": "doc-4"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "should reject notifications without types")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/oura", strings.NewReader(`{"event_type": "update", "data_type": "daily_sleep"}`)))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code, "should reject unsigned notifications")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, signedRequest("wrong-secret", `{"event_type": "update", "data_type": "daily_sleep"}`))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code, "should reject notifications signed with another secret")
+	assert.Len(t, got, 1, "should not handle rejected notifications")
+
+	assert.PanicsWithValue(t, "oura: nil WebhookEventFunc", func() { h.Handle(WebhookDataSession, nil) })
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/oura", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, "GET, POST", rec.Header().Get("Allow"))
+}
+
+func TestWebhookHandlerFetch(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/usercollection/daily_sleep/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/usercollection/daily_sleep/doc-1" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"detail": "Document not found."}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": "doc-1", "day": "2024-03-20", "score": 81}`)
+	})
+
+	h := NewWebhookHandler("s3cret", "client-secret")
+	var users []string
+	h.ClientFor = func(ctx context.Context, userID string) (*Client, error) {
+		users = append(users, userID)
+		if userID == "unknown" {
+			return nil, errors.New("no token")
+		}
+		return client, nil
+	}
+
+	var docs []interface{}
+	h.Handle(WebhookDataDailySleep, func(ctx context.Context, event *WebhookEvent, doc interface{}) error {
+		docs = append(docs, doc)
+		return nil
+	})
+
+	post := func(body string) int {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest("client-secret", body))
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, post(`{"event_type": "create", "data_type": "daily_sleep", "object_id": "doc-1", "user_id": "user-1"}`))
+	assert.Equal(t, http.StatusNoContent, post(`{"event_type": "delete", "data_type": "daily_sleep", "object_id": "doc-1", "user_id": "user-1"}`))
+	assert.Equal(t, http.StatusInternalServerError, post(`{"event_type": "update", "data_type": "daily_sleep", "object_id": "missing", "user_id": "user-1"}`))
+	assert.Equal(t, http.StatusInternalServerError, post(`{"event_type": "update", "data_type": "daily_sleep", "object_id": "doc-1", "user_id": "unknown"}`))
+
+	score := 81
+	assert.Equal(t, []interface{}{&DailySleep{ID: "doc-1", Day: "2024-03-20", Score: &score}, nil}, docs, "should not fetch deleted documents")
+	assert.Equal(t, []string{"user-1", "user-1", "unknown"}, users)
+}
+
+func TestVerifySignature(t *testing.T) {
+	now := time.Unix(1710936000, 0)
+	body := []byte(`{"event_type": "update"}`)
+	sign := func(secret, timestamp string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp))
+		mac.Write(body)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	cases := []struct {
+		name      string
+		secret    string
+		timestamp string
+		signature string
+		err       string
+	}{
+		{"valid", "secret", "1710936000", sign("secret", "1710936000"), ""},
+		{"uppercase hex", "secret", "1710936000", strings.ToUpper(sign("secret", "1710936000")), ""},
+		{"within tolerance", "secret", "1710935800", sign("secret", "1710935800"), ""},
+		{"wrong secret", "secret", "1710936000", sign("other", "1710936000"), "signature mismatch"},
+		{"signature for another timestamp", "secret", "1710936001", sign("secret", "1710936000"), "signature mismatch"},
+		{"stale", "secret", "1710935000", sign("secret", "1710935000"), "timestamp 1710935000 is outside the tolerance of 5m0s"},
+		{"future", "secret", "1710937000", sign("secret", "1710937000"), "timestamp 1710937000 is outside the tolerance of 5m0s"},
+		{"missing timestamp", "secret", "", sign("secret", ""), `invalid timestamp ""`},
+		{"missing signature", "secret", "1710936000", "", "missing or invalid signature"},
+		{"invalid signature", "secret", "1710936000", "not-hex", "missing or invalid signature"},
+		{"no client secret", "", "1710936000", sign("", "1710936000"), "no client secret to verify the signature with"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("x-oura-timestamp", tc.timestamp)
+			header.Set("x-oura-signature", tc.signature)
+			err := verifySignature(tc.secret, DefaultWebhookTolerance, now, header, body)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestWebhookHandlerConcurrentHandle(t *testing.T) {
+	h := NewWebhookHandler("s3cret", "client-secret")
+	body := `{"event_type": "update", "data_type": "daily_sleep", "object_id": "doc-1", "user_id": "user-1"}`
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			h.Handle(WebhookDataDailySleep, func(context.Context, *WebhookEvent, interface{}) error { return nil })
+		}()
+		go func() {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, signedRequest("client-secret", body))
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDocumentGetters(t *testing.T) {
+	for _, dt := range []WebhookDataType{
+		WebhookDataTag, WebhookDataEnhancedTag, WebhookDataWorkout, WebhookDataSession, WebhookDataSleep,
+		WebhookDataDailySleep, WebhookDataDailyReadiness, WebhookDataDailyActivity, WebhookDataDailySpO2,
+		WebhookDataSleepTime, WebhookDataRestModePeriod, WebhookDataRingConfiguration, WebhookDataDailyStress,
+		WebhookDataDailyResilience, WebhookDataDailyCardiovascularAge, WebhookDataVO2Max,
+	} {
+		assert.Contains(t, documentGetters, dt, "should have a getter for every data type")
+	}
+}
@@ -0,0 +1,176 @@
+package oura
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const subscriptionJSON = `{
+	"id": "sub-1",
+	"callback_url": "https://example.com/oura",
+	"event_type": "create",
+	"data_type": "daily_sleep",
+	"expiration_time": "2024-03-20T12:00:00Z"
+}`
+
+// webhookSetup establishes a test server for a WebhookClient. It checks every request is
+// authenticated with the application's credentials.
+func webhookSetup(t *testing.T) (client *WebhookClient, mux *http.ServeMux, teardown func()) {
+	t.Helper()
+	mux = http.NewServeMux()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-id", r.Header.Get("x-client-id"))
+		assert.Equal(t, "my-secret", r.Header.Get("x-client-secret"))
+		assert.Empty(t, r.Header.Get("Authorization"), "should not send a bearer token")
+		mux.ServeHTTP(w, r)
+	}))
+
+	c, err := NewWebhookClient(nil, "my-id", "my-secret", WithBaseURL(server.URL+"/"))
+	assert.NoError(t, err, "should not return an error")
+
+	return c, mux, server.Close
+}
+
+func TestNewWebhookClient(t *testing.T) {
+	_, err := NewWebhookClient(nil, "", "secret")
+	assert.EqualError(t, err, "oura: client ID and secret are required")
+
+	_, err = NewWebhookClient(nil, "id", "secret", WithMaxPages(0))
+	assert.Error(t, err, "should return option errors")
+}
+
+func TestSubscriptions(t *testing.T) {
+	client, mux, teardown := webhookSetup(t)
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhook/subscription", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprintf(w, `[%s]`, subscriptionJSON)
+	})
+
+	got, _, err := client.Subscriptions(context.Background())
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, []WebhookSubscription{{
+		ID:             "sub-1",
+		CallbackURL:    "https://example.com/oura",
+		EventType:      WebhookEventCreate,
+		DataType:       WebhookDataDailySleep,
+		ExpirationTime: time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC),
+	}}, got)
+}
+
+func TestCreateSubscription(t *testing.T) {
+	client, mux, teardown := webhookSetup(t)
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhook/subscription", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{
+			"callback_url":       "https://example.com/oura",
+			"verification_token": "s3cret",
+			"event_type":         "create",
+			"data_type":          "daily_sleep",
+		}, body)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, subscriptionJSON)
+	})
+
+	got, resp, err := client.CreateSubscription(context.Background(), &WebhookSubscriptionRequest{
+		CallbackURL:       "https://example.com/oura",
+		VerificationToken: "s3cret",
+		EventType:         WebhookEventCreate,
+		DataType:          WebhookDataDailySleep,
+	})
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "sub-1", got.ID)
+
+	_, _, err = client.CreateSubscription(context.Background(), nil)
+	assert.EqualError(t, err, "oura: subscription request is required")
+}
+
+func TestUpdateSubscription(t *testing.T) {
+	client, mux, teardown := webhookSetup(t)
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhook/subscription/sub-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{
+			"verification_token": "s3cret",
+			"event_type":         "update",
+		}, body, "should only send the fields being changed")
+		fmt.Fprint(w, subscriptionJSON)
+	})
+
+	got, _, err := client.UpdateSubscription(context.Background(), "sub-1", &WebhookSubscriptionUpdate{
+		VerificationToken: "s3cret",
+		EventType:         WebhookEventUpdate,
+	})
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, "sub-1", got.ID)
+
+	_, _, err = client.UpdateSubscription(context.Background(), "", &WebhookSubscriptionUpdate{})
+	assert.EqualError(t, err, "oura: subscription ID is required")
+}
+
+func TestRenewSubscription(t *testing.T) {
+	client, mux, teardown := webhookSetup(t)
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhook/subscription/renew/sub-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		fmt.Fprint(w, subscriptionJSON)
+	})
+
+	got, _, err := client.RenewSubscription(context.Background(), "sub-1")
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC), got.ExpirationTime.UTC())
+}
+
+func TestGetAndDeleteSubscription(t *testing.T) {
+	client, mux, teardown := webhookSetup(t)
+	defer teardown()
+
+	deleted := false
+	mux.HandleFunc("/v2/webhook/subscription/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/webhook/subscription/sub-1" || deleted {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"detail": "Subscription not found."}`)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, subscriptionJSON)
+		case http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.GetSubscription(ctx, "sub-1")
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, WebhookDataDailySleep, got.DataType)
+
+	resp, err := client.DeleteSubscription(ctx, "sub-1")
+	assert.NoError(t, err, "should not return an error")
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	_, _, err = client.GetSubscription(ctx, "sub-1")
+	assert.True(t, IsNotFound(err), "should return a not found error")
+	_, err = client.DeleteSubscription(ctx, "sub-1")
+	assert.True(t, IsNotFound(err), "should return a not found error")
+}
@@ -2,6 +2,7 @@ package oura
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -23,6 +24,9 @@ type Workout struct {
 	// ISO 8601 formatted local timestamp indicating when the workout ended
 	EndDatetime time.Time `json:"end_datetime"`
 
+	// Unique identifier of the document
+	ID string `json:"id"`
+
 	// The workout intensity:
 	// * `easy`
 	// * `moderate`
@@ -50,6 +54,13 @@ type Workouts struct {
 	NextToken *string `json:"next_token,omitempty"`
 }
 
+func (d *Workouts) page() ([]Workout, *string) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.Data, d.NextToken
+}
+
 // Workouts gets the workout data within a given timeframe.
 // If a start and end date are not provided, ie are empty strings, we fall back to Oura's defaults which are:
 //
@@ -70,3 +81,48 @@ func (c *Client) Workouts(ctx context.Context, startDate, endDate, nextToken str
 
 	return data, resp, nil
 }
+
+// WorkoutsAll gets the workout data within a given timeframe, following the pagination tokens until
+// all of the pages have been fetched. If a page fails to be fetched, the data fetched so far is
+// returned along with the error.
+func (c *Client) WorkoutsAll(ctx context.Context, startDate, endDate string) ([]Workout, error) {
+	return ListAll(ctx, c.maxPages, Pages(c.Workouts, startDate, endDate))
+}
+
+// WorkoutsSeq returns an iterator over the workout data within a given timeframe. Each page is
+// fetched when the iteration reaches it, and fetching stops when the iteration does. If a page
+// fails to be fetched, the error is yielded and the iteration ends.
+func (c *Client) WorkoutsSeq(ctx context.Context, startDate, endDate string) iter.Seq2[Workout, error] {
+	return Seq(ctx, c.maxPages, Pages(c.Workouts, startDate, endDate))
+}
+
+// WorkoutsWithOptions gets the workout data using typed, validated parameters. An error wrapping
+// ErrInvalidRange is returned, without making a request, if the options are invalid.
+func (c *Client) WorkoutsWithOptions(ctx context.Context, opts *ListOptions) (*Workouts, *http.Response, error) {
+	start, end, next, err := opts.params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.Workouts(ctx, start, end, next)
+}
+
+// GetWorkout gets a single workout by its ID. An error matching ErrNotFound is returned if there is
+// no workout with the ID.
+func (c *Client) GetWorkout(ctx context.Context, id string) (*Workout, *http.Response, error) {
+	path, err := documentPath("workout", id)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data *Workout
+	resp, err := c.do(req, &data)
+	if err != nil {
+		return data, resp, err
+	}
+
+	return data, resp, nil
+}